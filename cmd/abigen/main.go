@@ -0,0 +1,53 @@
+// Command abigen generates typed Go bindings for a smart contract from its ABI JSON
+//
+//	go run github.com/klever-io/klever-go-sdk/cmd/abigen -abi lottery-kda.abi.json -pkg lottery -out lottery.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/klever-io/klever-go-sdk/provider/abigen"
+)
+
+func main() {
+	abiPath := flag.String("abi", "", "path of the contract ABI JSON")
+	out := flag.String("out", "", "output file, stdout if empty")
+	pkg := flag.String("pkg", "", "package name, defaults to the lower case contract name")
+	typeName := flag.String("type", "", "binding type name, defaults to the ABI name")
+	flag.Parse()
+
+	if len(*abiPath) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*abiPath, *out, abigen.Options{Package: *pkg, TypeName: *typeName}); err != nil {
+		fmt.Fprintln(os.Stderr, "abigen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiPath, out string, options abigen.Options) error {
+	file, err := os.Open(abiPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	options.Source = filepath.Base(abiPath)
+
+	source, err := abigen.Generate(file, options)
+	if err != nil {
+		return err
+	}
+
+	if len(out) == 0 {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+
+	return os.WriteFile(out, source, 0644)
+}
@@ -0,0 +1,4 @@
+// Package lottery holds the typed bindings of the lottery-kda demo contract
+package lottery
+
+//go:generate go run ../../../../abigen -abi ../../scFiles/lottery-kda.abi.json -pkg lottery -out lottery.go
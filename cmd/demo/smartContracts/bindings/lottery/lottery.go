@@ -0,0 +1,496 @@
+// Code generated by abigen from lottery-kda.abi.json. DO NOT EDIT.
+
+package lottery
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+)
+
+// abiJSON is the ABI the bindings were generated from
+const abiJSON = `{
+  "buildInfo": {
+    "rustc": {
+      "version": "1.79.0-nightly",
+      "commitHash": "0824b300eb0dae5d9ed59719d3f2732016683d66",
+      "commitDate": "2024-03-24",
+      "channel": "Nightly",
+      "short": "rustc 1.79.0-nightly (0824b300e 2024-03-24)"
+    },
+    "contractCrate": {
+      "name": "lottery-kda",
+      "version": "0.0.0",
+      "gitVersion": "v0.43.3-155-g2a0ab8b3b"
+    },
+    "framework": {
+      "name": "klever-sc",
+      "version": "0.43.3"
+    }
+  },
+  "name": "Lottery",
+  "constructor": {
+    "inputs": [],
+    "outputs": []
+  },
+  "endpoints": [
+    {
+      "name": "start",
+      "mutability": "mutable",
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        },
+        {
+          "name": "token_identifier",
+          "type": "TokenIdentifier"
+        },
+        {
+          "name": "ticket_price",
+          "type": "BigUint"
+        },
+        {
+          "name": "opt_total_tickets",
+          "type": "Option<u32>"
+        },
+        {
+          "name": "opt_deadline",
+          "type": "Option<u64>"
+        },
+        {
+          "name": "opt_max_entries_per_user",
+          "type": "Option<u32>"
+        },
+        {
+          "name": "opt_prize_distribution",
+          "type": "Option<bytes>"
+        },
+        {
+          "name": "opt_whitelist",
+          "type": "Option<List<Address>>"
+        },
+        {
+          "name": "opt_burn_percentage",
+          "type": "optional<BigUint>",
+          "multi_arg": true
+        }
+      ],
+      "outputs": []
+    },
+    {
+      "name": "createLotteryPool",
+      "mutability": "mutable",
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        },
+        {
+          "name": "token_identifier",
+          "type": "TokenIdentifier"
+        },
+        {
+          "name": "ticket_price",
+          "type": "BigUint"
+        },
+        {
+          "name": "opt_total_tickets",
+          "type": "Option<u32>"
+        },
+        {
+          "name": "opt_deadline",
+          "type": "Option<u64>"
+        },
+        {
+          "name": "opt_max_entries_per_user",
+          "type": "Option<u32>"
+        },
+        {
+          "name": "opt_prize_distribution",
+          "type": "Option<bytes>"
+        },
+        {
+          "name": "opt_whitelist",
+          "type": "Option<List<Address>>"
+        },
+        {
+          "name": "opt_burn_percentage",
+          "type": "optional<BigUint>",
+          "multi_arg": true
+        }
+      ],
+      "outputs": []
+    },
+    {
+      "name": "buy_ticket",
+      "mutability": "mutable",
+      "payableInTokens": ["*"],
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        }
+      ],
+      "outputs": []
+    },
+    {
+      "name": "determine_winner",
+      "mutability": "mutable",
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        }
+      ],
+      "outputs": []
+    },
+    {
+      "name": "status",
+      "mutability": "readonly",
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        }
+      ],
+      "outputs": [
+        {
+          "type": "Status"
+        }
+      ]
+    },
+    {
+      "name": "getLotteryInfo",
+      "mutability": "readonly",
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        }
+      ],
+      "outputs": [
+        {
+          "type": "LotteryInfo"
+        }
+      ]
+    },
+    {
+      "name": "getWinnersInfo",
+      "mutability": "readonly",
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        }
+      ],
+      "outputs": [
+        {
+          "type": "List<WinnerInfo>"
+        }
+      ]
+    },
+    {
+      "name": "getLotteryWhitelist",
+      "mutability": "readonly",
+      "inputs": [
+        {
+          "name": "lottery_name",
+          "type": "bytes"
+        }
+      ],
+      "outputs": [
+        {
+          "type": "variadic<Address>",
+          "multi_result": true
+        }
+      ]
+    }
+  ],
+  "types": {
+    "LotteryInfo": {
+      "type": "struct",
+      "fields": [
+        {
+          "name": "token_identifier",
+          "type": "TokenIdentifier"
+        },
+        {
+          "name": "ticket_price",
+          "type": "BigUint"
+        },
+        {
+          "name": "tickets_left",
+          "type": "u32"
+        },
+        {
+          "name": "deadline",
+          "type": "u64"
+        },
+        {
+          "name": "max_entries_per_user",
+          "type": "u32"
+        },
+        {
+          "name": "prize_distribution",
+          "type": "bytes"
+        },
+        {
+          "name": "prize_pool",
+          "type": "BigUint"
+        }
+      ]
+    },
+    "Status": {
+      "type": "enum",
+      "variants": [
+        {
+          "name": "Inactive",
+          "discriminant": 0
+        },
+        {
+          "name": "Running",
+          "discriminant": 1
+        },
+        {
+          "name": "Ended",
+          "discriminant": 2
+        }
+      ]
+    },
+    "WinnerInfo": {
+      "type": "struct",
+      "fields": [
+        {
+          "name": "drawn_ticket_number",
+          "type": "u32"
+        },
+        {
+          "name": "winner_address",
+          "type": "Address"
+        },
+        {
+          "name": "prize",
+          "type": "BigUint"
+        }
+      ]
+    }
+  }
+}
+`
+
+type LotteryInfo struct {
+	TokenIdentifier   string   `abi:"token_identifier"`
+	TicketPrice       *big.Int `abi:"ticket_price"`
+	TicketsLeft       uint32   `abi:"tickets_left"`
+	Deadline          uint64   `abi:"deadline"`
+	MaxEntriesPerUser uint32   `abi:"max_entries_per_user"`
+	PrizeDistribution string   `abi:"prize_distribution"`
+	PrizePool         *big.Int `abi:"prize_pool"`
+}
+
+type Status string
+
+const (
+	StatusInactive Status = "Inactive"
+	StatusRunning  Status = "Running"
+	StatusEnded    Status = "Ended"
+)
+
+type WinnerInfo struct {
+	DrawnTicketNumber uint32   `abi:"drawn_ticket_number"`
+	WinnerAddress     string   `abi:"winner_address"`
+	Prize             *big.Int `abi:"prize"`
+}
+
+// Lottery is a typed binding for the Lottery smart contract
+type Lottery struct {
+	address string
+	kc      provider.KleverChain
+	encoder provider.VMInputEncoder
+	decoder provider.VMOutputData
+}
+
+// NewLottery creates a binding for the contract deployed at scAddress
+func NewLottery(kc provider.KleverChain, scAddress string) (*Lottery, error) {
+	encoder, decoder, err := newCodecs()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Lottery{address: scAddress, kc: kc, encoder: encoder, decoder: decoder}, nil
+}
+
+func newCodecs() (provider.VMInputEncoder, provider.VMOutputData, error) {
+	encoder := provider.NewVMInputEncoder()
+	if err := encoder.LoadAbi(strings.NewReader(abiJSON)); err != nil {
+		return nil, nil, err
+	}
+
+	decoder := provider.NewVMOutputHandler()
+	if err := decoder.LoadAbi(strings.NewReader(abiJSON)); err != nil {
+		return nil, nil, err
+	}
+
+	return encoder, decoder, nil
+}
+
+// Address returns the smart contract address
+func (c *Lottery) Address() string {
+	return c.address
+}
+
+// Deploy builds the deploy transaction of the contract with wasm read from wasmPath
+func Deploy(kc provider.KleverChain, base *models.BaseTX, wasmPath string, payable, payableBySC, upgradeable, readable bool) (*proto.Transaction, error) {
+	encoder, _, err := newCodecs()
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := encoder.EncodeConstructor()
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.DeploySmartContract(base, wasmPath, payable, payableBySC, upgradeable, readable, "", args.Arguments()...)
+}
+
+// Start builds a transaction calling the start endpoint
+func (c *Lottery) Start(base *models.BaseTX, lotteryName string, tokenIdentifier string, ticketPrice *big.Int, optTotalTickets *uint32, optDeadline *uint64, optMaxEntriesPerUser *uint32, optPrizeDistribution *string, optWhitelist *[]string, optBurnPercentage *big.Int) (*proto.Transaction, error) {
+	args, err := c.encoder.EncodeEndpoint("start", lotteryName, tokenIdentifier, ticketPrice, optTotalTickets, optDeadline, optMaxEntriesPerUser, optPrizeDistribution, optWhitelist, optBurnPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.kc.InvokeSmartContract(base, c.address, "start", map[string]int64{}, args.Arguments()...)
+}
+
+// CreateLotteryPool builds a transaction calling the createLotteryPool endpoint
+func (c *Lottery) CreateLotteryPool(base *models.BaseTX, lotteryName string, tokenIdentifier string, ticketPrice *big.Int, optTotalTickets *uint32, optDeadline *uint64, optMaxEntriesPerUser *uint32, optPrizeDistribution *string, optWhitelist *[]string, optBurnPercentage *big.Int) (*proto.Transaction, error) {
+	args, err := c.encoder.EncodeEndpoint("createLotteryPool", lotteryName, tokenIdentifier, ticketPrice, optTotalTickets, optDeadline, optMaxEntriesPerUser, optPrizeDistribution, optWhitelist, optBurnPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.kc.InvokeSmartContract(base, c.address, "createLotteryPool", map[string]int64{}, args.Arguments()...)
+}
+
+// BuyTicket builds a transaction calling the buy_ticket endpoint
+func (c *Lottery) BuyTicket(base *models.BaseTX, callValue map[string]int64, lotteryName string) (*proto.Transaction, error) {
+	args, err := c.encoder.EncodeEndpoint("buy_ticket", lotteryName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.kc.InvokeSmartContract(base, c.address, "buy_ticket", callValue, args.Arguments()...)
+}
+
+// DetermineWinner builds a transaction calling the determine_winner endpoint
+func (c *Lottery) DetermineWinner(base *models.BaseTX, lotteryName string) (*proto.Transaction, error) {
+	args, err := c.encoder.EncodeEndpoint("determine_winner", lotteryName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.kc.InvokeSmartContract(base, c.address, "determine_winner", map[string]int64{}, args.Arguments()...)
+}
+
+// Status queries the readonly status endpoint
+func (c *Lottery) Status(ctx context.Context, lotteryName string) (Status, error) {
+	var r0 Status
+
+	args, err := c.encoder.EncodeEndpoint("status", lotteryName)
+	if err != nil {
+		return r0, err
+	}
+
+	result, err := c.kc.QuerySmartContract(ctx, c.address, "status", args.Arguments()...)
+	if err != nil {
+		return r0, err
+	}
+
+	decoded, err := c.decoder.DecodeQuery("status", result.ReturnData)
+	if err != nil {
+		return r0, err
+	}
+
+	err = provider.DecodeInto(decoded, &r0)
+
+	return r0, err
+}
+
+// GetLotteryInfo queries the readonly getLotteryInfo endpoint
+func (c *Lottery) GetLotteryInfo(ctx context.Context, lotteryName string) (LotteryInfo, error) {
+	var r0 LotteryInfo
+
+	args, err := c.encoder.EncodeEndpoint("getLotteryInfo", lotteryName)
+	if err != nil {
+		return r0, err
+	}
+
+	result, err := c.kc.QuerySmartContract(ctx, c.address, "getLotteryInfo", args.Arguments()...)
+	if err != nil {
+		return r0, err
+	}
+
+	decoded, err := c.decoder.DecodeQuery("getLotteryInfo", result.ReturnData)
+	if err != nil {
+		return r0, err
+	}
+
+	err = provider.DecodeInto(decoded, &r0)
+
+	return r0, err
+}
+
+// GetWinnersInfo queries the readonly getWinnersInfo endpoint
+func (c *Lottery) GetWinnersInfo(ctx context.Context, lotteryName string) ([]WinnerInfo, error) {
+	var r0 []WinnerInfo
+
+	args, err := c.encoder.EncodeEndpoint("getWinnersInfo", lotteryName)
+	if err != nil {
+		return r0, err
+	}
+
+	result, err := c.kc.QuerySmartContract(ctx, c.address, "getWinnersInfo", args.Arguments()...)
+	if err != nil {
+		return r0, err
+	}
+
+	decoded, err := c.decoder.DecodeQuery("getWinnersInfo", result.ReturnData)
+	if err != nil {
+		return r0, err
+	}
+
+	err = provider.DecodeInto(decoded, &r0)
+
+	return r0, err
+}
+
+// GetLotteryWhitelist queries the readonly getLotteryWhitelist endpoint
+func (c *Lottery) GetLotteryWhitelist(ctx context.Context, lotteryName string) ([]string, error) {
+	var r0 []string
+
+	args, err := c.encoder.EncodeEndpoint("getLotteryWhitelist", lotteryName)
+	if err != nil {
+		return r0, err
+	}
+
+	result, err := c.kc.QuerySmartContract(ctx, c.address, "getLotteryWhitelist", args.Arguments()...)
+	if err != nil {
+		return r0, err
+	}
+
+	decoded, err := c.decoder.DecodeQuery("getLotteryWhitelist", result.ReturnData)
+	if err != nil {
+		return r0, err
+	}
+
+	err = provider.DecodeInto(decoded, &r0)
+
+	return r0, err
+}
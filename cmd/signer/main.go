@@ -0,0 +1,88 @@
+// Command signer is a reference signing service for remotesigner clients, it signs with
+// the first key of a PEM file and requires client certificates signed by -client-ca.
+// Transaction hashes are only signed along the transaction they are the hash of
+//
+//	SIGNER_PEM_PASSWORD=secret go run github.com/klever-io/klever-go-sdk/cmd/signer \
+//		-pem wallet.pem -cert server.crt -key server.key -client-ca ca.crt
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/core/remotesigner"
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+)
+
+func main() {
+	pemFile := flag.String("pem", "", "PEM file of the signing key, its password is read from SIGNER_PEM_PASSWORD")
+	listen := flag.String("listen", ":8443", "address to listen on")
+	certFile := flag.String("cert", "", "server certificate")
+	keyFile := flag.String("key", "", "server certificate key")
+	clientCA := flag.String("client-ca", "", "CA of the accepted client certificates")
+	flag.Parse()
+
+	if len(*pemFile) == 0 || len(*certFile) == 0 || len(*keyFile) == 0 || len(*clientCA) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*pemFile, *listen, *certFile, *keyFile, *clientCA); err != nil {
+		fmt.Fprintln(os.Stderr, "signer:", err)
+		os.Exit(1)
+	}
+}
+
+func run(pemFile, listen, certFile, keyFile, clientCA string) error {
+	privateKey, addr, err := wallet.LoadKey(pemFile, 0, os.Getenv("SIGNER_PEM_PASSWORD"))
+	if err != nil {
+		return err
+	}
+
+	if len(privateKey) < 32 {
+		return fmt.Errorf("invalid private key size")
+	}
+
+	w, err := wallet.NewWallet(privateKey[:32])
+	if err != nil {
+		return err
+	}
+
+	handler, err := remotesigner.NewHandler(w, func(r *remotesigner.SignRequest) error {
+		if r.Transaction != nil {
+			log.Printf("signing transaction of %s nonce %d contracts %v", r.Transaction.Sender, r.Transaction.Nonce, r.Transaction.ContractTypes)
+		} else {
+			log.Printf("signing message %s", r.Message)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := remotesigner.NewServerTLSConfig(certFile, keyFile, clientCA)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           handler,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Printf("signing for %s on %s", addr, listen)
+
+	err = server.ListenAndServeTLS("", "")
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
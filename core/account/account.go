@@ -2,6 +2,7 @@ package account
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/klever-io/klever-go-sdk/core/address"
@@ -13,7 +14,9 @@ type account struct {
 	address address.Address
 	info    *models.Account
 
-	lastUpdate time.Time
+	mut          sync.RWMutex
+	nonceManager NonceManager
+	lastUpdate   time.Time
 }
 
 func NewAccount(addr address.Address) (Account, error) {
@@ -26,7 +29,10 @@ func (a *account) Address() address.Address {
 }
 
 func (a *account) Balance() int64 {
-	if a.info != nil {
+	a.mut.RLock()
+	defer a.mut.RUnlock()
+
+	if a.info != nil && a.info.AccountInfo != nil {
 		return a.info.Balance
 	}
 
@@ -34,7 +40,10 @@ func (a *account) Balance() int64 {
 }
 
 func (a *account) Nonce() uint64 {
-	if a.info != nil {
+	a.mut.RLock()
+	defer a.mut.RUnlock()
+
+	if a.info != nil && a.info.AccountInfo != nil {
 		return a.info.Nonce
 	}
 
@@ -42,17 +51,45 @@ func (a *account) Nonce() uint64 {
 }
 
 func (a *account) IncrementNonce() {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	if a.info == nil {
+		a.info = &models.Account{}
+	}
+	if a.info.AccountInfo == nil {
+		a.info.AccountInfo = &models.AccountInfo{}
+	}
+
 	a.info.Nonce += 1
 }
 
+// SetNonceManager makes NewBaseTX take nonces from the manager instead of the account info,
+// use it when several goroutines send transactions from the same account
+func (a *account) SetNonceManager(nm NonceManager) {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+
+	a.nonceManager = nm
+}
+
+func (a *account) NonceManager() NonceManager {
+	a.mut.RLock()
+	defer a.mut.RUnlock()
+
+	return a.nonceManager
+}
+
 func (a *account) SyncWithContext(ctx context.Context, p provider.KleverChain) error {
 	acc, err := p.GetAccountWithContext(ctx, a.address.Bech32())
 	if err != nil {
 		return err
 	}
 
+	a.mut.Lock()
 	a.info = acc
 	a.lastUpdate = time.Now()
+	a.mut.Unlock()
 
 	return nil
 }
@@ -62,17 +99,28 @@ func (a *account) Sync(p provider.KleverChain) error {
 }
 
 func (a *account) LastUpdate() time.Time {
+	a.mut.RLock()
+	defer a.mut.RUnlock()
+
 	return a.lastUpdate
 }
 
 func (a *account) GetInfo() *models.Account {
+	a.mut.RLock()
+	defer a.mut.RUnlock()
+
 	return a.info
 }
 
 func (a *account) NewBaseTX() *models.BaseTX {
+	nonce := a.Nonce()
+	if nm := a.NonceManager(); nm != nil {
+		nonce = nm.Next()
+	}
+
 	return &models.BaseTX{
 		FromAddress: a.address.Bech32(),
-		Nonce:       a.Nonce(),
+		Nonce:       nonce,
 		PermID:      0, // Default owner
 		Message:     make([]string, 0),
 	}
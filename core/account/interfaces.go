@@ -14,9 +14,21 @@ type Account interface {
 	Balance() int64
 	Nonce() uint64
 	IncrementNonce()
+	SetNonceManager(NonceManager)
+	NonceManager() NonceManager
 	Sync(provider.KleverChain) error
 	SyncWithContext(context.Context, provider.KleverChain) error
 	LastUpdate() time.Time
 	GetInfo() *models.Account
 	NewBaseTX() *models.BaseTX
 }
+
+type NonceManager interface {
+	Next() uint64
+	Release(nonce uint64)
+	Reset(nonce uint64)
+	Current() uint64
+	Sync(provider.KleverChain) error
+	SyncWithContext(context.Context, provider.KleverChain) error
+	Gaps(context.Context, provider.KleverChain) ([]uint64, error)
+}
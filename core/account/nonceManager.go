@@ -0,0 +1,150 @@
+package account
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/provider"
+)
+
+type nonceManager struct {
+	address address.Address
+
+	mut      sync.Mutex
+	next     uint64
+	released []uint64
+}
+
+// NewNonceManager creates a goroutine safe nonce manager starting at `nonce`
+func NewNonceManager(addr address.Address, nonce uint64) NonceManager {
+	return &nonceManager{
+		address:  addr,
+		next:     nonce,
+		released: make([]uint64, 0),
+	}
+}
+
+// NewNonceManagerFromChain creates a nonce manager initialized with the account nonce from the node
+func NewNonceManagerFromChain(ctx context.Context, addr address.Address, p provider.KleverChain) (NonceManager, error) {
+	nm := NewNonceManager(addr, 0)
+	if err := nm.SyncWithContext(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return nm, nil
+}
+
+// Next hands out the lowest nonce taken back by Release or the next unused one
+func (nm *nonceManager) Next() uint64 {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	if len(nm.released) > 0 {
+		nonce := nm.released[0]
+		nm.released = nm.released[1:]
+		return nonce
+	}
+
+	nonce := nm.next
+	nm.next++
+
+	return nonce
+}
+
+// Release takes back a nonce whose transaction was never accepted by the node
+func (nm *nonceManager) Release(nonce uint64) {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	if nonce >= nm.next {
+		return
+	}
+
+	index := sort.Search(len(nm.released), func(i int) bool { return nm.released[i] >= nonce })
+	if index < len(nm.released) && nm.released[index] == nonce {
+		return
+	}
+
+	nm.released = append(nm.released, 0)
+	copy(nm.released[index+1:], nm.released[index:])
+	nm.released[index] = nonce
+
+	// shrink the counter while the highest nonces were all given back
+	for len(nm.released) > 0 && nm.released[len(nm.released)-1] == nm.next-1 {
+		nm.released = nm.released[:len(nm.released)-1]
+		nm.next--
+	}
+}
+
+// Reset discards the local state and restarts at `nonce`
+func (nm *nonceManager) Reset(nonce uint64) {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	nm.next = nonce
+	nm.released = make([]uint64, 0)
+}
+
+// Current returns the next nonce without handing it out
+func (nm *nonceManager) Current() uint64 {
+	nm.mut.Lock()
+	defer nm.mut.Unlock()
+
+	if len(nm.released) > 0 {
+		return nm.released[0]
+	}
+
+	return nm.next
+}
+
+func (nm *nonceManager) SyncWithContext(ctx context.Context, p provider.KleverChain) error {
+	nonce, err := nm.chainNonce(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	nm.Reset(nonce)
+
+	return nil
+}
+
+func (nm *nonceManager) Sync(p provider.KleverChain) error {
+	return nm.SyncWithContext(context.Background(), p)
+}
+
+// Gaps returns the nonces from the node nonce up to the next nonce to hand out, the ones
+// handed out or released that the node has not executed yet. The lowest of them blocks
+// every transaction after it. The local state is not changed, use Sync to restart from
+// the node nonce.
+func (nm *nonceManager) Gaps(ctx context.Context, p provider.KleverChain) ([]uint64, error) {
+	chainNonce, err := nm.chainNonce(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	nm.mut.Lock()
+	next := nm.next
+	nm.mut.Unlock()
+
+	gaps := make([]uint64, 0)
+	for nonce := chainNonce; nonce < next; nonce++ {
+		gaps = append(gaps, nonce)
+	}
+
+	return gaps, nil
+}
+
+func (nm *nonceManager) chainNonce(ctx context.Context, p provider.KleverChain) (uint64, error) {
+	acc, err := p.GetAccountWithContext(ctx, nm.address.Bech32())
+	if err != nil {
+		return 0, err
+	}
+
+	if acc == nil || acc.AccountInfo == nil {
+		return 0, nil
+	}
+
+	return acc.Nonce, nil
+}
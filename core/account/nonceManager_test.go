@@ -0,0 +1,144 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/core/account"
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+
+func newTestProvider(t *testing.T, nonce *atomic.Uint64) provider.KleverChain {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":{"account":{"address":"%s","nonce":%d}},"code":"successful"}`, testAddress, nonce.Load())
+	}))
+	t.Cleanup(server.Close)
+
+	kc, err := provider.NewKleverChain(
+		network.NewNetworkConfigCustom(server.URL, server.URL, server.URL),
+		utils.NewHttpClient(time.Second),
+	)
+	require.Nil(t, err)
+
+	return kc
+}
+
+func TestNonceManager_NextAndRelease(t *testing.T) {
+	addr, err := address.NewAddress(testAddress)
+	require.Nil(t, err)
+
+	nm := account.NewNonceManager(addr, 10)
+	assert.Equal(t, uint64(10), nm.Next())
+	assert.Equal(t, uint64(11), nm.Next())
+	assert.Equal(t, uint64(12), nm.Next())
+
+	// releasing the last nonce moves the counter back
+	nm.Release(12)
+	assert.Equal(t, uint64(12), nm.Current())
+
+	// a nonce in the middle is handed out again before new ones
+	nm.Release(10)
+	nm.Release(10)
+	nm.Release(99)
+	assert.Equal(t, uint64(10), nm.Next())
+	assert.Equal(t, uint64(12), nm.Next())
+	assert.Equal(t, uint64(13), nm.Next())
+}
+
+func TestNonceManager_Concurrent(t *testing.T) {
+	addr, err := address.NewAddress(testAddress)
+	require.Nil(t, err)
+
+	nm := account.NewNonceManager(addr, 0)
+
+	var mut sync.Mutex
+	seen := make(map[uint64]bool)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce := nm.Next()
+
+			mut.Lock()
+			seen[nonce] = true
+			mut.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, 50)
+	assert.Equal(t, uint64(50), nm.Current())
+}
+
+func TestNonceManager_SyncAndGaps(t *testing.T) {
+	addr, err := address.NewAddress(testAddress)
+	require.Nil(t, err)
+
+	chainNonce := atomic.Uint64{}
+	chainNonce.Store(5)
+	kc := newTestProvider(t, &chainNonce)
+
+	nm, err := account.NewNonceManagerFromChain(context.Background(), addr, kc)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), nm.Current())
+
+	for i := 0; i < 4; i++ {
+		nm.Next()
+	}
+	nm.Release(5)
+	nm.Release(6)
+
+	// handed out nonces are gaps until the node executes them, released or not
+	gaps, err := nm.Gaps(context.Background(), kc)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{5, 6, 7, 8}, gaps)
+
+	// the node already used nonce 5
+	chainNonce.Store(6)
+	gaps, err = nm.Gaps(context.Background(), kc)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{6, 7, 8}, gaps)
+
+	// reporting gaps doesn't change the manager
+	assert.Equal(t, uint64(5), nm.Current())
+
+	chainNonce.Store(9)
+	gaps, err = nm.Gaps(context.Background(), kc)
+	assert.Nil(t, err)
+	assert.Empty(t, gaps)
+
+	chainNonce.Store(20)
+	err = nm.Sync(kc)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(20), nm.Next())
+}
+
+func TestAccount_NewBaseTXWithNonceManager(t *testing.T) {
+	addr, err := address.NewAddress(testAddress)
+	require.Nil(t, err)
+
+	acc, err := account.NewAccount(addr)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), acc.NewBaseTX().Nonce)
+
+	acc.IncrementNonce()
+	assert.Equal(t, uint64(1), acc.NewBaseTX().Nonce)
+
+	acc.SetNonceManager(account.NewNonceManager(addr, 7))
+	assert.Equal(t, uint64(7), acc.NewBaseTX().Nonce)
+	assert.Equal(t, uint64(8), acc.NewBaseTX().Nonce)
+}
@@ -0,0 +1,18 @@
+package keystore
+
+import "github.com/klever-io/klever-go-sdk/core/wallet"
+
+// Keystore manages a directory of password encrypted PEM keys, one file per address
+type Keystore interface {
+	Create(name string, password string) (*KeyInfo, error)
+	ImportHex(name string, privateHex string, password string) (*KeyInfo, error)
+	ImportMnemonic(name string, mnemonic string, password string, path ...wallet.WOHDPath) (*KeyInfo, error)
+	ImportPEM(name string, pemFile string, pemPassword string, password string) (*KeyInfo, error)
+	List() ([]*KeyInfo, error)
+	Get(nameOrAddress string) (*KeyInfo, error)
+	Unlock(address string, password string) (wallet.Wallet, error)
+	Rename(address string, name string) error
+	Export(address string, password string, exportPassword string) ([]byte, error)
+	ChangePassword(address string, oldPassword string, newPassword string) error
+	Delete(address string) error
+}
@@ -0,0 +1,394 @@
+package keystore
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+)
+
+const (
+	pemExtension   = ".pem"
+	pemBlockPrefix = "PRIVATE KEY for "
+	nameHeader     = "Name"
+)
+
+// ErrKeyNotFound is returned when no key of the keystore matches the address or name
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrKeyExists is returned when importing a key already in the keystore
+var ErrKeyExists = errors.New("key already exists")
+
+// ErrNameExists is returned when another key of the keystore has the name
+var ErrNameExists = errors.New("key name already exists")
+
+// KeyInfo describes a stored key, it is read without decrypting the key
+type KeyInfo struct {
+	Address   string
+	Name      string
+	Path      string
+	Encrypted bool
+}
+
+type keystore struct {
+	dir string
+}
+
+// NewKeystore opens the keystore at `dir`, creating the directory if needed
+func NewKeystore(dir string) (Keystore, error) {
+	if len(dir) == 0 {
+		return nil, fmt.Errorf("invalid keystore directory")
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &keystore{dir: dir}, nil
+}
+
+// Create stores a new random key
+func (ks *keystore) Create(name string, password string) (*KeyInfo, error) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+
+	return ks.store(name, seed, password)
+}
+
+// ImportHex stores the hex encoded private key
+func (ks *keystore) ImportHex(name string, privateHex string, password string) (*KeyInfo, error) {
+	privateKey, err := hex.DecodeString(privateHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	return ks.store(name, privateKey, password)
+}
+
+// ImportMnemonic stores the key derived from the mnemonic, `path` selects the derivation as in wallet.NewWalletFromMnemonic
+func (ks *keystore) ImportMnemonic(name string, mnemonic string, password string, path ...wallet.WOHDPath) (*KeyInfo, error) {
+	w, err := wallet.NewWalletFromMnemonic(mnemonic, path...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.store(name, w.PrivateKey(), password)
+}
+
+// ImportPEM stores the first key of a PEM file, `pemPassword` is only needed for encrypted files
+func (ks *keystore) ImportPEM(name string, pemFile string, pemPassword string, password string) (*KeyInfo, error) {
+	privateKey, _, err := wallet.LoadKey(pemFile, 0, pemPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(privateKey) < ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid private key size")
+	}
+
+	return ks.store(name, privateKey[:ed25519.SeedSize], password)
+}
+
+// List returns the stored keys sorted by name and address, files that can't be
+// read as a keystore key are skipped
+func (ks *keystore) List() ([]*KeyInfo, error) {
+	entries, err := os.ReadDir(ks.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*KeyInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != pemExtension {
+			continue
+		}
+
+		info, _, err := ks.readKey(filepath.Join(ks.dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		keys = append(keys, info)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Name != keys[j].Name {
+			return keys[i].Name < keys[j].Name
+		}
+		return keys[i].Address < keys[j].Address
+	})
+
+	return keys, nil
+}
+
+// Get finds a key by address or by name
+func (ks *keystore) Get(nameOrAddress string) (*KeyInfo, error) {
+	if _, err := address.NewAddress(nameOrAddress); err == nil {
+		info, _, err := ks.load(nameOrAddress)
+		return info, err
+	}
+
+	keys, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if key.Name == nameOrAddress {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, nameOrAddress)
+}
+
+// Unlock decrypts the key of `address`
+func (ks *keystore) Unlock(address string, password string) (wallet.Wallet, error) {
+	_, block, err := ks.load(address)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := decryptKey(block, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.NewWallet(privateKey)
+}
+
+// Rename changes the name of a key, the key stays encrypted
+func (ks *keystore) Rename(address string, name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	info, block, err := ks.load(address)
+	if err != nil {
+		return err
+	}
+
+	if err := ks.checkName(name, info.Address); err != nil {
+		return err
+	}
+
+	setName(block, name)
+
+	return wallet.WritePEMFile(info.Path, block)
+}
+
+// Export returns the key as a PEM file readable by wallet.LoadKey, encrypted with
+// `exportPassword` or in plain text if it is empty
+func (ks *keystore) Export(address string, password string, exportPassword string) ([]byte, error) {
+	info, block, err := ks.load(address)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := decryptKey(block, password)
+	if err != nil {
+		return nil, err
+	}
+
+	exported, err := encodeBlock(info.Name, privateKey, exportPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(exported), nil
+}
+
+// ChangePassword encrypts the key of `address` with a new password
+func (ks *keystore) ChangePassword(address string, oldPassword string, newPassword string) error {
+	if len(newPassword) == 0 {
+		return fmt.Errorf("keystore keys must be encrypted with a password")
+	}
+
+	info, block, err := ks.load(address)
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := decryptKey(block, oldPassword)
+	if err != nil {
+		return err
+	}
+
+	newBlock, err := encodeBlock(info.Name, privateKey, newPassword)
+	if err != nil {
+		return err
+	}
+
+	return wallet.WritePEMFile(info.Path, newBlock)
+}
+
+// Delete removes the key of `address` from the keystore
+func (ks *keystore) Delete(address string) error {
+	info, _, err := ks.load(address)
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(info.Path)
+}
+
+func (ks *keystore) store(name string, privateKey []byte, password string) (*KeyInfo, error) {
+	if len(password) == 0 {
+		return nil, fmt.Errorf("keystore keys must be encrypted with a password")
+	}
+
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	w, err := wallet.NewWallet(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := w.GetAccount()
+	if err != nil {
+		return nil, err
+	}
+	addr := acc.Address().Bech32()
+
+	path := ks.keyPath(addr)
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrKeyExists, addr)
+	}
+
+	if err := ks.checkName(name, addr); err != nil {
+		return nil, err
+	}
+
+	block, err := encodeBlock(name, privateKey, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wallet.WritePEMFile(path, block); err != nil {
+		return nil, err
+	}
+
+	return &KeyInfo{Address: addr, Name: name, Path: path, Encrypted: true}, nil
+}
+
+// checkName rejects a name used by a key other than `addr`, keys may be left without a name
+func (ks *keystore) checkName(name string, addr string) error {
+	if len(name) == 0 {
+		return nil
+	}
+
+	keys, err := ks.List()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if key.Name == name && key.Address != addr {
+			return fmt.Errorf("%w: %s is used by %s", ErrNameExists, name, key.Address)
+		}
+	}
+
+	return nil
+}
+
+func (ks *keystore) keyPath(addr string) string {
+	return filepath.Join(ks.dir, addr+pemExtension)
+}
+
+func (ks *keystore) load(addr string) (*KeyInfo, *pem.Block, error) {
+	if _, err := address.NewAddress(addr); err != nil {
+		return nil, nil, fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+
+	path := ks.keyPath(addr)
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("%w: %s", ErrKeyNotFound, addr)
+	}
+
+	return ks.readKey(path)
+}
+
+func (ks *keystore) readKey(path string) (*KeyInfo, *pem.Block, error) {
+	buff, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	block, _ := pem.Decode(buff)
+	if block == nil || !strings.HasPrefix(block.Type, pemBlockPrefix) {
+		return nil, nil, fmt.Errorf("invalid pem file %s", path)
+	}
+
+	return &KeyInfo{
+		Address:   strings.TrimPrefix(block.Type, pemBlockPrefix),
+		Name:      block.Headers[nameHeader],
+		Path:      path,
+		Encrypted: wallet.IsEncryptedPEMBlock(block),
+	}, block, nil
+}
+
+func decryptKey(block *pem.Block, password string) ([]byte, error) {
+	if wallet.IsEncryptedPEMBlock(block) {
+		decrypted, err := wallet.DecryptPEMBlock(block, password)
+		if err != nil {
+			return nil, fmt.Errorf("failed PEM decryption: %w", err)
+		}
+		block = decrypted
+	}
+
+	privateKey, err := hex.DecodeString(string(block.Bytes))
+	if err != nil {
+		return nil, fmt.Errorf("%w for encoded secret key", err)
+	}
+
+	if len(privateKey) < ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid private key size")
+	}
+
+	return privateKey[:ed25519.SeedSize], nil
+}
+
+func encodeBlock(name string, privateKey []byte, password string) (*pem.Block, error) {
+	block, err := wallet.EncodePEMBlock(privateKey, password)
+	if err != nil {
+		return nil, err
+	}
+
+	setName(block, name)
+
+	return block, nil
+}
+
+// validateName rejects names that can't be stored in a PEM header
+func validateName(name string) error {
+	if strings.ContainsAny(name, "\r\n") || strings.TrimSpace(name) != name {
+		return fmt.Errorf("invalid key name %q", name)
+	}
+
+	return nil
+}
+
+func setName(block *pem.Block, name string) {
+	if len(name) == 0 {
+		delete(block.Headers, nameHeader)
+		return
+	}
+
+	if block.Headers == nil {
+		block.Headers = make(map[string]string)
+	}
+	block.Headers[nameHeader] = name
+}
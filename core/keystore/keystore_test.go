@@ -0,0 +1,183 @@
+package keystore_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/core/keystore"
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+)
+
+const (
+	testPrivateKey = "8734062c1158f26a3ca8a4a0da87b527a7c168653f7f4c77045e5cf571497d9d"
+	testAddress    = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+	testMnemonic   = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+)
+
+func newTestKeystore(t *testing.T) (keystore.Keystore, string) {
+	dir := filepath.Join(t.TempDir(), "keys")
+	ks, err := keystore.NewKeystore(dir)
+	require.Nil(t, err)
+
+	return ks, dir
+}
+
+func TestKeystore_Import_And_Unlock(t *testing.T) {
+	ks, _ := newTestKeystore(t)
+
+	info, err := ks.ImportHex("main", testPrivateKey, "secret")
+	require.Nil(t, err)
+	assert.Equal(t, testAddress, info.Address)
+	assert.True(t, info.Encrypted)
+
+	w, err := ks.Unlock(testAddress, "secret")
+	require.Nil(t, err)
+	assert.Equal(t, testPrivateKey, hex.EncodeToString(w.PrivateKey()))
+
+	_, err = ks.Unlock(testAddress, "wrong")
+	assert.NotNil(t, err)
+
+	// the same key derived from the mnemonic
+	_, err = ks.ImportMnemonic("again", testMnemonic, "secret")
+	assert.True(t, errors.Is(err, keystore.ErrKeyExists))
+
+	second, err := ks.ImportMnemonic("second", testMnemonic, "secret", wallet.WOHDPath{Prefix: 690, Index: 1})
+	require.Nil(t, err)
+	assert.NotEqual(t, testAddress, second.Address)
+
+	created, err := ks.Create("", "secret")
+	require.Nil(t, err)
+
+	keys, err := ks.List()
+	require.Nil(t, err)
+	require.Len(t, keys, 3)
+	assert.Equal(t, created.Address, keys[0].Address)
+	assert.Equal(t, "main", keys[1].Name)
+	assert.Equal(t, "second", keys[2].Name)
+
+	found, err := ks.Get("second")
+	require.Nil(t, err)
+	assert.Equal(t, second.Address, found.Address)
+
+	_, err = ks.Create("no password", "")
+	assert.NotNil(t, err)
+}
+
+func TestKeystore_Export_And_Import_PEM(t *testing.T) {
+	ks, _ := newTestKeystore(t)
+
+	_, err := ks.ImportHex("main", testPrivateKey, "secret")
+	require.Nil(t, err)
+
+	exported, err := ks.Export(testAddress, "secret", "export")
+	require.Nil(t, err)
+
+	pemFile := filepath.Join(t.TempDir(), "exported.pem")
+	require.Nil(t, os.WriteFile(pemFile, exported, 0600))
+
+	privateKey, blockType, err := wallet.LoadKey(pemFile, 0, "export")
+	require.Nil(t, err)
+	assert.Equal(t, testPrivateKey, hex.EncodeToString(privateKey))
+	assert.Equal(t, testAddress, blockType)
+
+	other, _ := newTestKeystore(t)
+	info, err := other.ImportPEM("imported", pemFile, "export", "other")
+	require.Nil(t, err)
+	assert.Equal(t, testAddress, info.Address)
+
+	plain, err := ks.Export(testAddress, "secret", "")
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(pemFile, plain, 0600))
+
+	w, err := wallet.NewWalletFromPEM(pemFile)
+	require.Nil(t, err)
+	assert.Equal(t, testPrivateKey, hex.EncodeToString(w.PrivateKey()))
+}
+
+func TestKeystore_Manage_Keys(t *testing.T) {
+	ks, dir := newTestKeystore(t)
+
+	_, err := ks.ImportHex("main", testPrivateKey, "secret")
+	require.Nil(t, err)
+
+	stat, err := os.Stat(filepath.Join(dir, testAddress+".pem"))
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	require.Nil(t, ks.Rename(testAddress, "renamed"))
+	info, err := ks.Get(testAddress)
+	require.Nil(t, err)
+	assert.Equal(t, "renamed", info.Name)
+	assert.NotNil(t, ks.Rename(testAddress, "two\nlines"))
+
+	require.Nil(t, ks.ChangePassword(testAddress, "secret", "new secret"))
+	_, err = ks.Unlock(testAddress, "secret")
+	assert.NotNil(t, err)
+	_, err = ks.Unlock(testAddress, "new secret")
+	assert.Nil(t, err)
+
+	info, err = ks.Get("renamed")
+	require.Nil(t, err)
+	assert.Equal(t, testAddress, info.Address)
+
+	assert.NotNil(t, ks.ChangePassword(testAddress, "wrong", "other"))
+
+	require.Nil(t, ks.Delete(testAddress))
+	_, err = ks.Get(testAddress)
+	assert.True(t, errors.Is(err, keystore.ErrKeyNotFound))
+	assert.True(t, errors.Is(ks.Delete(testAddress), keystore.ErrKeyNotFound))
+
+	keys, err := ks.List()
+	require.Nil(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestKeystore_Unique_Names(t *testing.T) {
+	ks, _ := newTestKeystore(t)
+
+	_, err := ks.ImportHex("main", testPrivateKey, "secret")
+	require.Nil(t, err)
+
+	_, err = ks.Create("main", "secret")
+	assert.True(t, errors.Is(err, keystore.ErrNameExists))
+
+	other, err := ks.Create("other", "secret")
+	require.Nil(t, err)
+
+	assert.True(t, errors.Is(ks.Rename(other.Address, "main"), keystore.ErrNameExists))
+	assert.Nil(t, ks.Rename(testAddress, "main"))
+
+	// keys without a name don't clash
+	require.Nil(t, ks.Rename(testAddress, ""))
+	require.Nil(t, ks.Rename(other.Address, ""))
+	_, err = ks.Create("", "secret")
+	assert.Nil(t, err)
+
+	keys, err := ks.List()
+	require.Nil(t, err)
+	assert.Len(t, keys, 3)
+}
+
+func TestKeystore_List_Skips_Invalid_Files(t *testing.T) {
+	ks, dir := newTestKeystore(t)
+
+	_, err := ks.ImportHex("main", testPrivateKey, "secret")
+	require.Nil(t, err)
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "broken.pem"), []byte("not a pem file"), 0600))
+
+	keys, err := ks.List()
+	require.Nil(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, testAddress, keys[0].Address)
+
+	info, err := ks.Get("main")
+	require.Nil(t, err)
+	assert.Equal(t, testAddress, info.Address)
+}
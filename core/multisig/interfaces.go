@@ -0,0 +1,19 @@
+package multisig
+
+import (
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+// PartialTransaction is a transaction collecting the signatures of a multisig permission,
+// it can be exported and handed to the next signer until the threshold is reached
+type PartialTransaction interface {
+	Transaction() *proto.Transaction
+	PermissionID() int32
+	Signers() []string
+	AddSignature(signer string, signature []byte) error
+	Sign(signer proto.Signer, signerAddress string) error
+	Verify(permission *models.Permissions) (*Verification, error)
+	VerifyAccount(account *models.Account) (*Verification, error)
+	Export() ([]byte, error)
+}
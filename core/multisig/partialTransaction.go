@@ -0,0 +1,300 @@
+package multisig
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/tools/hasher"
+	"github.com/klever-io/klever-go-sdk/provider/tools/marshal"
+)
+
+// FormatVersion is the version of the exported partial transaction
+const FormatVersion = 1
+
+type signature struct {
+	Signer    string `json:"signer"`
+	Signature string `json:"signature"`
+}
+
+type exportedTransaction struct {
+	Version      uint32      `json:"version"`
+	PermissionID int32       `json:"permissionID"`
+	Transaction  string      `json:"tx"`
+	Signatures   []signature `json:"signatures"`
+}
+
+type partialTransaction struct {
+	tx         *proto.Transaction
+	signers    []string
+	signatures map[string][]byte
+}
+
+// Verification is the result of checking the collected signatures against a permission
+type Verification struct {
+	PermissionID int32
+	Weight       int64
+	Threshold    int64
+	// Signers are the collected signers that belong to the permission,
+	// Unknown the ones that don't and add no weight
+	Signers []string
+	Unknown []string
+}
+
+// ThresholdReached reports whether the signers weight is enough to broadcast the transaction
+func (v *Verification) ThresholdReached() bool {
+	return v.Weight >= v.Threshold
+}
+
+// NewPartialTransaction starts collecting signatures for tx, the permission used is the
+// PermissionID of its raw data. The transaction must not be signed yet, since the
+// signatures can't be bound to their signers
+func NewPartialTransaction(tx *proto.Transaction) (PartialTransaction, error) {
+	if tx == nil || tx.GetRawData() == nil {
+		return nil, fmt.Errorf("transaction without raw data")
+	}
+
+	if len(tx.GetSignature()) != 0 {
+		return nil, fmt.Errorf("transaction already signed, add its signatures with AddSignature")
+	}
+
+	hash, err := computeHash(tx.GetRawData())
+	if err != nil {
+		return nil, err
+	}
+
+	return &partialTransaction{
+		tx: &proto.Transaction{
+			RawData:       tx.GetRawData(),
+			GasLimit:      tx.GetGasLimit(),
+			GasMultiplier: tx.GetGasMultiplier(),
+			Hash:          hash,
+		},
+		signatures: make(map[string][]byte),
+	}, nil
+}
+
+// Import loads a partial transaction produced by Export, every signature is verified
+func Import(data []byte) (PartialTransaction, error) {
+	var exported exportedTransaction
+	if err := json.Unmarshal(data, &exported); err != nil {
+		return nil, fmt.Errorf("invalid partial transaction: %w", err)
+	}
+
+	if exported.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported partial transaction version %d", exported.Version)
+	}
+
+	txBytes, err := base64.StdEncoding.DecodeString(exported.Transaction)
+	if err != nil {
+		return nil, fmt.Errorf("invalid partial transaction tx: %w", err)
+	}
+
+	tx := &proto.Transaction{}
+	if err := marshal.NewProtoMarshalizer().Unmarshal(tx, txBytes); err != nil {
+		return nil, fmt.Errorf("invalid partial transaction tx: %w", err)
+	}
+
+	if tx.GetRawData().GetPermissionID() != exported.PermissionID {
+		return nil, fmt.Errorf("permission id %d differs from the transaction permission %d", exported.PermissionID, tx.GetRawData().GetPermissionID())
+	}
+
+	ptx, err := NewPartialTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range exported.Signatures {
+		sig, err := hex.DecodeString(s.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature of %s: %w", s.Signer, err)
+		}
+
+		if err := ptx.AddSignature(s.Signer, sig); err != nil {
+			return nil, err
+		}
+	}
+
+	return ptx, nil
+}
+
+// Transaction returns the transaction with the signatures collected so far, in the order they were added
+func (pt *partialTransaction) Transaction() *proto.Transaction {
+	tx := &proto.Transaction{
+		RawData:       pt.tx.RawData,
+		GasLimit:      pt.tx.GasLimit,
+		GasMultiplier: pt.tx.GasMultiplier,
+		Hash:          append([]byte{}, pt.tx.Hash...),
+	}
+
+	for _, signer := range pt.signers {
+		tx.AddSignature(append([]byte{}, pt.signatures[signer]...))
+	}
+
+	return tx
+}
+
+// PermissionID returns the permission the transaction is signed with
+func (pt *partialTransaction) PermissionID() int32 {
+	return pt.tx.GetRawData().GetPermissionID()
+}
+
+// Signers returns the addresses that signed the transaction
+func (pt *partialTransaction) Signers() []string {
+	return append([]string{}, pt.signers...)
+}
+
+// AddSignature adds the signature of `signer`, it must sign the transaction hash
+// with the signer key. A new signature of the same signer replaces the previous one
+func (pt *partialTransaction) AddSignature(signer string, sig []byte) error {
+	if _, err := address.NewAddress(signer); err != nil {
+		return fmt.Errorf("invalid signer %s: %w", signer, err)
+	}
+
+	if !models.VerifySignature(signer, pt.tx.Hash, sig) {
+		return fmt.Errorf("invalid signature of %s", signer)
+	}
+
+	if _, exists := pt.signatures[signer]; !exists {
+		pt.signers = append(pt.signers, signer)
+	}
+	pt.signatures[signer] = append([]byte{}, sig...)
+
+	return nil
+}
+
+// Sign signs the transaction with `signer` and adds the signature as the one of `signerAddress`,
+// signers needing the whole transaction (e.g. a remote signer) sign a copy of it
+func (pt *partialTransaction) Sign(signer proto.Signer, signerAddress string) error {
+	if signer == nil {
+		return fmt.Errorf("nil signer")
+	}
+
+	tx := &proto.Transaction{
+		RawData:       pt.tx.RawData,
+		GasLimit:      pt.tx.GasLimit,
+		GasMultiplier: pt.tx.GasMultiplier,
+		Hash:          append([]byte{}, pt.tx.Hash...),
+	}
+
+	if err := tx.Sign(signer); err != nil {
+		return err
+	}
+
+	if len(tx.GetSignature()) != 1 {
+		return fmt.Errorf("signer added %d signatures, expected one", len(tx.GetSignature()))
+	}
+
+	return pt.AddSignature(signerAddress, tx.GetSignature()[0])
+}
+
+// Verify sums the weight of the collected signers in `permission`, the permission
+// must allow every contract of the transaction
+func (pt *partialTransaction) Verify(permission *models.Permissions) (*Verification, error) {
+	if permission == nil {
+		return nil, fmt.Errorf("nil permission")
+	}
+
+	if permission.ID != pt.PermissionID() {
+		return nil, fmt.Errorf("transaction uses permission %d, got permission %d", pt.PermissionID(), permission.ID)
+	}
+
+	sender, err := address.NewAddressFromBytes(pt.tx.GetRawData().GetSender())
+	if err != nil {
+		return nil, err
+	}
+
+	return pt.verify(&models.Account{AccountInfo: &models.AccountInfo{
+		Address:     sender.Bech32(),
+		Permissions: []models.Permissions{*permission},
+	}})
+}
+
+// VerifyAccount is Verify with the transaction permission of the sender account
+func (pt *partialTransaction) VerifyAccount(account *models.Account) (*Verification, error) {
+	if account == nil || account.AccountInfo == nil {
+		return nil, fmt.Errorf("account info not loaded")
+	}
+
+	return pt.verify(account)
+}
+
+func (pt *partialTransaction) verify(account *models.Account) (*Verification, error) {
+	// the checks of the permission don't depend on the signer
+	check, err := account.CheckPermission(pt.PermissionID(), "", pt.tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(check.Missing) != 0 {
+		return nil, fmt.Errorf("permission %d doesn't allow %v", check.PermissionID, check.Missing)
+	}
+
+	verification := &Verification{
+		PermissionID: check.PermissionID,
+		Threshold:    check.Threshold,
+	}
+
+	for _, signer := range pt.signers {
+		check, err := account.CheckPermission(pt.PermissionID(), signer, pt.tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !check.Allowed() {
+			verification.Unknown = append(verification.Unknown, signer)
+			continue
+		}
+
+		verification.Signers = append(verification.Signers, signer)
+		verification.Weight += check.Weight
+	}
+
+	return verification, nil
+}
+
+// Export serializes the transaction and its signatures to be shared with the other signers
+func (pt *partialTransaction) Export() ([]byte, error) {
+	txBytes, err := marshal.NewProtoMarshalizer().Marshal(&proto.Transaction{
+		RawData:       pt.tx.RawData,
+		GasLimit:      pt.tx.GasLimit,
+		GasMultiplier: pt.tx.GasMultiplier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exported := exportedTransaction{
+		Version:      FormatVersion,
+		PermissionID: pt.PermissionID(),
+		Transaction:  base64.StdEncoding.EncodeToString(txBytes),
+		Signatures:   make([]signature, 0, len(pt.signers)),
+	}
+
+	for _, signer := range pt.signers {
+		exported.Signatures = append(exported.Signatures, signature{
+			Signer:    signer,
+			Signature: hex.EncodeToString(pt.signatures[signer]),
+		})
+	}
+
+	return json.Marshal(exported)
+}
+
+func computeHash(raw *proto.Transaction_Raw) ([]byte, error) {
+	h, err := hasher.NewHasher()
+	if err != nil {
+		return nil, err
+	}
+
+	rawBytes, err := marshal.NewProtoMarshalizer().Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Compute(string(rawBytes)), nil
+}
@@ -0,0 +1,234 @@
+package multisig_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/core/multisig"
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+func newTestWallet(t *testing.T, seed byte) (wallet.Wallet, string) {
+	w, err := wallet.NewWallet(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+	require.Nil(t, err)
+
+	acc, err := w.GetAccount()
+	require.Nil(t, err)
+
+	return w, acc.Address().Bech32()
+}
+
+func sign(ptx multisig.PartialTransaction, w wallet.Wallet) error {
+	acc, err := w.GetAccount()
+	if err != nil {
+		return err
+	}
+
+	return ptx.Sign(w, acc.Address().Bech32())
+}
+
+// transactionSigner signs like a remote signer, adding the signature to the transaction
+type transactionSigner struct {
+	wallet.Wallet
+	signed []*proto.Transaction
+}
+
+func (ts *transactionSigner) SignTransaction(_ context.Context, tx *proto.Transaction) error {
+	ts.signed = append(ts.signed, tx)
+
+	sig, err := ts.Wallet.Sign(tx.Hash)
+	if err != nil {
+		return err
+	}
+
+	tx.AddSignature(sig)
+
+	return nil
+}
+
+func newTestAccount(t *testing.T) (*models.Account, []wallet.Wallet) {
+	operations, err := models.NewPermissionOperations(proto.TXContract_TransferContractType)
+	require.Nil(t, err)
+
+	owner, ownerAddr := newTestWallet(t, 1)
+	second, secondAddr := newTestWallet(t, 2)
+	third, thirdAddr := newTestWallet(t, 3)
+
+	account := &models.Account{AccountInfo: &models.AccountInfo{
+		Address: ownerAddr,
+		Permissions: []models.Permissions{
+			{ID: 0, Threshold: 1, Signers: []models.PermissionKey{{Address: ownerAddr, Weight: 1}}},
+			{ID: 2, Type: models.PermissionTypeUser, Threshold: 3, Operations: operations.String(), Signers: []models.PermissionKey{
+				{Address: ownerAddr, Weight: 1},
+				{Address: secondAddr, Weight: 2},
+				{Address: thirdAddr, Weight: 1},
+			}},
+		},
+	}}
+
+	return account, []wallet.Wallet{owner, second, third}
+}
+
+func newTestTransaction(t *testing.T, w wallet.Wallet, permID int32) *proto.Transaction {
+	return newTestContractTransaction(t, proto.TXContract_TransferContractType, w, permID)
+}
+
+func newTestContractTransaction(t *testing.T, contractType proto.TXContract_ContractType, w wallet.Wallet, permID int32) *proto.Transaction {
+	return &proto.Transaction{
+		RawData: &proto.Transaction_Raw{
+			Nonce:        4,
+			Sender:       w.PublicKey(),
+			PermissionID: permID,
+			ChainID:      []byte("100420"),
+			Contract:     []*proto.TXContract{{Type: contractType}},
+		},
+		GasLimit: 1000,
+	}
+}
+
+func Test_PartialTransaction_Collect_Signatures(t *testing.T) {
+	account, wallets := newTestAccount(t)
+
+	ptx, err := multisig.NewPartialTransaction(newTestTransaction(t, wallets[0], 2))
+	require.Nil(t, err)
+	require.Nil(t, sign(ptx, wallets[0]))
+
+	verification, err := ptx.VerifyAccount(account)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), verification.Weight)
+	assert.False(t, verification.ThresholdReached())
+
+	// the second signer receives the exported transaction
+	exported, err := ptx.Export()
+	require.Nil(t, err)
+
+	imported, err := multisig.Import(exported)
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), imported.PermissionID())
+	assert.Equal(t, ptx.Signers(), imported.Signers())
+
+	require.Nil(t, sign(imported, wallets[1]))
+
+	verification, err = imported.VerifyAccount(account)
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), verification.Weight)
+	assert.Equal(t, int64(3), verification.Threshold)
+	assert.True(t, verification.ThresholdReached())
+	assert.Empty(t, verification.Unknown)
+
+	tx := imported.Transaction()
+	assert.Equal(t, uint64(1000), tx.GetGasLimit())
+	require.Len(t, tx.GetSignature(), 2)
+	for i, w := range wallets[:2] {
+		assert.True(t, ed25519.Verify(w.PublicKey(), tx.Hash, tx.GetSignature()[i]))
+	}
+}
+
+func Test_PartialTransaction_Unknown_And_Duplicated_Signers(t *testing.T) {
+	account, wallets := newTestAccount(t)
+	outsider, outsiderAddr := newTestWallet(t, 9)
+
+	ptx, err := multisig.NewPartialTransaction(newTestTransaction(t, wallets[0], 2))
+	require.Nil(t, err)
+
+	require.Nil(t, sign(ptx, wallets[2]))
+	require.Nil(t, sign(ptx, wallets[2]))
+	require.Nil(t, sign(ptx, outsider))
+
+	verification, err := ptx.VerifyAccount(account)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), verification.Weight)
+	assert.Equal(t, []string{outsiderAddr}, verification.Unknown)
+	assert.Len(t, ptx.Transaction().GetSignature(), 2)
+}
+
+func Test_PartialTransaction_Errors(t *testing.T) {
+	account, wallets := newTestAccount(t)
+	_, secondAddr := newTestWallet(t, 2)
+
+	ptx, err := multisig.NewPartialTransaction(newTestTransaction(t, wallets[0], 2))
+	require.Nil(t, err)
+
+	// signature of another key
+	sig, err := wallets[0].Sign(ptx.Transaction().Hash)
+	require.Nil(t, err)
+	assert.NotNil(t, ptx.AddSignature(secondAddr, sig))
+	assert.NotNil(t, ptx.AddSignature("invalid", sig))
+
+	_, err = ptx.Verify(&account.Permissions[0])
+	assert.NotNil(t, err)
+
+	missing, err := multisig.NewPartialTransaction(newTestTransaction(t, wallets[0], 5))
+	require.Nil(t, err)
+	_, err = missing.VerifyAccount(account)
+	assert.NotNil(t, err)
+
+	signed := newTestTransaction(t, wallets[0], 0)
+	signed.AddSignature(sig)
+	_, err = multisig.NewPartialTransaction(signed)
+	assert.NotNil(t, err)
+
+	_, err = multisig.Import([]byte(`{"version": 2}`))
+	assert.NotNil(t, err)
+
+	exported, err := ptx.Export()
+	require.Nil(t, err)
+	tampered := bytes.Replace(exported, []byte(`"permissionID":2`), []byte(`"permissionID":0`), 1)
+	_, err = multisig.Import(tampered)
+	assert.NotNil(t, err)
+}
+
+func Test_PartialTransaction_Operation_Not_Allowed(t *testing.T) {
+	account, wallets := newTestAccount(t)
+
+	ptx, err := multisig.NewPartialTransaction(newTestContractTransaction(t, proto.TXContract_FreezeContractType, wallets[0], 2))
+	require.Nil(t, err)
+	require.Nil(t, sign(ptx, wallets[0]))
+	require.Nil(t, sign(ptx, wallets[1]))
+
+	_, err = ptx.VerifyAccount(account)
+	assert.NotNil(t, err)
+
+	_, err = ptx.Verify(&account.Permissions[1])
+	assert.NotNil(t, err)
+
+	// the owner permission allows any contract
+	owner, err := multisig.NewPartialTransaction(newTestContractTransaction(t, proto.TXContract_FreezeContractType, wallets[0], 0))
+	require.Nil(t, err)
+	require.Nil(t, sign(owner, wallets[0]))
+
+	verification, err := owner.VerifyAccount(account)
+	require.Nil(t, err)
+	assert.True(t, verification.ThresholdReached())
+}
+
+func Test_PartialTransaction_Transaction_Signer(t *testing.T) {
+	account, wallets := newTestAccount(t)
+	_, secondAddr := newTestWallet(t, 2)
+	signer := &transactionSigner{Wallet: wallets[1]}
+
+	ptx, err := multisig.NewPartialTransaction(newTestTransaction(t, wallets[0], 2))
+	require.Nil(t, err)
+	require.Nil(t, sign(ptx, wallets[0]))
+	require.Nil(t, ptx.Sign(signer, secondAddr))
+
+	// the signer receives the transaction without the collected signatures
+	require.Len(t, signer.signed, 1)
+	assert.Len(t, signer.signed[0].GetSignature(), 1)
+	assert.Len(t, ptx.Transaction().GetSignature(), 2)
+
+	verification, err := ptx.VerifyAccount(account)
+	require.Nil(t, err)
+	assert.True(t, verification.ThresholdReached())
+
+	// signatures are bound to the given address
+	_, thirdAddr := newTestWallet(t, 3)
+	assert.NotNil(t, ptx.Sign(signer, thirdAddr))
+}
@@ -0,0 +1,182 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options/options"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Config of the remote signer client
+type Config struct {
+	// URL is the base url of the signing service
+	URL string
+	// Address is the account the service signs for, the returned
+	// signatures are verified against its public key
+	Address string
+	// TLSConfig holds the client certificate for mutual authentication, see NewClientTLSConfig
+	TLSConfig *tls.Config
+	Timeout   time.Duration
+	// HttpClient replaces the client built from TLSConfig and Timeout
+	HttpClient utils.HttpClient
+}
+
+type remoteSigner struct {
+	url        string
+	address    string
+	publicKey  ed25519.PublicKey
+	httpClient utils.HttpClient
+}
+
+// NewRemoteSigner creates a signer that asks the signing service at cfg.URL for signatures
+func NewRemoteSigner(cfg Config) (RemoteSigner, error) {
+	if len(cfg.URL) == 0 {
+		return nil, fmt.Errorf("signing service url must be provided")
+	}
+
+	addr, err := address.NewAddress(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	httpClient := cfg.HttpClient
+	if httpClient == nil {
+		timeout := cfg.Timeout
+		if timeout == 0 {
+			timeout = defaultTimeout
+		}
+
+		var clientOptions []utils.ClientOption
+		if cfg.TLSConfig != nil {
+			clientOptions = append(clientOptions, utils.WithTransport(&http.Transport{TLSClientConfig: cfg.TLSConfig}))
+		}
+
+		httpClient = utils.NewHttpClient(timeout, clientOptions...)
+	}
+
+	return &remoteSigner{
+		url:        strings.TrimSuffix(cfg.URL, "/"),
+		address:    addr.Bech32(),
+		publicKey:  ed25519.PublicKey(addr.Bytes()),
+		httpClient: httpClient,
+	}, nil
+}
+
+// Address returns the address of the remote key
+func (rs *remoteSigner) Address() string {
+	return rs.address
+}
+
+// PublicKey returns the public key of the remote key
+func (rs *remoteSigner) PublicKey() []byte {
+	return append([]byte{}, rs.publicKey...)
+}
+
+// Sign implements proto.Signer, the service receives the message without context and the
+// reference service refuses it. Transaction.Sign and SignableMessage.Sign use
+// SignTransaction and SignMessage instead
+func (rs *remoteSigner) Sign(msg []byte) ([]byte, error) {
+	return rs.SignWithContext(context.Background(), msg, nil)
+}
+
+// SignWithContext asks the service to sign msg, `txContext` must describe the transaction
+// whose hash is msg. The signature is verified before being returned
+func (rs *remoteSigner) SignWithContext(ctx context.Context, msg []byte, txContext *TransactionContext) ([]byte, error) {
+	return rs.sign(ctx, SignPath, msg, txContext, msg)
+}
+
+// SignMessage signs the message as SignableMessage.Sign does, see SignMessageWithContext
+func (rs *remoteSigner) SignMessage(message []byte) ([]byte, error) {
+	return rs.SignMessageWithContext(context.Background(), message)
+}
+
+// SignMessageWithContext asks the service to sign the message in the SignableMessage format,
+// the signature is verified before being returned
+func (rs *remoteSigner) SignMessageWithContext(ctx context.Context, message []byte) ([]byte, error) {
+	sm := models.NewSM(rs.address, message)
+
+	signature, err := rs.sign(ctx, SignMessagePath, message, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.SetSignature(signature)
+	if !sm.Verify() {
+		return nil, fmt.Errorf("signing service returned a signature that doesn't match %s", rs.address)
+	}
+
+	return signature, nil
+}
+
+// sign posts the request to path and checks the signature against signed, when it's given
+func (rs *remoteSigner) sign(ctx context.Context, path string, msg []byte, txContext *TransactionContext, signed []byte) ([]byte, error) {
+	body, err := json.Marshal(SignRequest{
+		Address:     rs.address,
+		Message:     hex.EncodeToString(msg),
+		Transaction: txContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		Data *SignResponse `json:"data"`
+	}{}
+
+	err = rs.httpClient.Post(ctx, rs.url+path, string(body), nil, &result, options.NewIdempotent(true))
+	if err != nil {
+		return nil, fmt.Errorf("signing service error: %w", err)
+	}
+
+	if result.Data == nil {
+		return nil, fmt.Errorf("signing service returned no signature")
+	}
+
+	signature, err := hex.DecodeString(result.Data.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from signing service: %w", err)
+	}
+
+	if len(signature) != ed25519.SignatureSize || (signed != nil && !ed25519.Verify(rs.publicKey, signed, signature)) {
+		return nil, fmt.Errorf("signing service returned a signature that doesn't match %s", rs.address)
+	}
+
+	return signature, nil
+}
+
+// SignTransaction signs the transaction hash sending the decoded transaction as context
+// and appends the signature, it's used by Transaction.Sign
+func (rs *remoteSigner) SignTransaction(ctx context.Context, tx *proto.Transaction) error {
+	txContext, hash, err := NewTransactionContext(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(tx.Hash) != 0 && !bytes.Equal(tx.Hash, hash) {
+		return fmt.Errorf("transaction hash doesn't match its raw data")
+	}
+
+	signature, err := rs.SignWithContext(ctx, hash, txContext)
+	if err != nil {
+		return err
+	}
+
+	tx.Hash = hash
+	tx.AddSignature(signature)
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package remotesigner
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+	"github.com/klever-io/klever-go-sdk/models"
+)
+
+// Policy decides whether a request is signed, returning an error refuses it.
+// Transaction is nil for messages
+type Policy func(r *SignRequest) error
+
+type handler struct {
+	wallet  wallet.Wallet
+	address string
+	policy  Policy
+}
+
+// NewHandler returns the reference signing service, signing with a local wallet.
+// Transaction hashes are only signed on SignPath along the transaction context they are
+// the hash of, messages are only signed on SignMessagePath in the SignableMessage format.
+// Serve it with NewServerTLSConfig to require client certificates
+func NewHandler(w wallet.Wallet, policy Policy) (http.Handler, error) {
+	acc, err := w.GetAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	h := &handler{
+		wallet:  w,
+		address: acc.Address().Bech32(),
+		policy:  policy,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(SignPath, h.serve(h.signTransaction))
+	mux.HandleFunc(SignMessagePath, h.serve(h.signMessage))
+
+	return mux, nil
+}
+
+// serve decodes and authorizes the request before calling sign with the decoded message
+func (h *handler) serve(sign func(req *SignRequest, msg []byte) ([]byte, int, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		var req SignRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", fmt.Errorf("invalid request: %w", err))
+			return
+		}
+
+		if req.Address != h.address {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Errorf("no key for %s", req.Address))
+			return
+		}
+
+		msg, err := hex.DecodeString(req.Message)
+		if err != nil || len(msg) == 0 {
+			writeError(w, http.StatusBadRequest, "bad_request", fmt.Errorf("invalid message"))
+			return
+		}
+
+		signature, status, err := sign(&req, msg)
+		if err != nil {
+			writeError(w, status, codeOfStatus(status), err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": SignResponse{Signature: hex.EncodeToString(signature)},
+			"code": "successful",
+		})
+	}
+}
+
+func (h *handler) signTransaction(req *SignRequest, msg []byte) ([]byte, int, error) {
+	if req.Transaction == nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("transaction context is required")
+	}
+
+	hash, err := req.Transaction.Hash()
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	if !bytes.Equal(hash, msg) {
+		return nil, http.StatusBadRequest, fmt.Errorf("message is not the transaction hash")
+	}
+
+	if err := h.authorize(req); err != nil {
+		return nil, http.StatusForbidden, err
+	}
+
+	signature, err := h.wallet.Sign(msg)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return signature, http.StatusOK, nil
+}
+
+func (h *handler) signMessage(req *SignRequest, msg []byte) ([]byte, int, error) {
+	if req.Transaction != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("transactions are signed on %s", SignPath)
+	}
+
+	if err := h.authorize(req); err != nil {
+		return nil, http.StatusForbidden, err
+	}
+
+	sm := models.NewSM(h.address, msg)
+	if err := sm.Sign(h.wallet); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return sm.GetSignature(), http.StatusOK, nil
+}
+
+func (h *handler) authorize(req *SignRequest) error {
+	if h.policy == nil {
+		return nil
+	}
+
+	return h.policy(req)
+}
+
+func codeOfStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return "bad_request"
+	case http.StatusForbidden:
+		return "forbidden"
+	default:
+		return "internal_issue"
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code string, err error) {
+	writeJSON(w, status, map[string]interface{}{
+		"data":  nil,
+		"error": err.Error(),
+		"code":  code,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,19 @@
+package remotesigner
+
+import (
+	"context"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+// RemoteSigner is a proto.Signer whose key is held by a signing service,
+// it can be used with Transaction.Sign and SignableMessage.Sign
+type RemoteSigner interface {
+	proto.TransactionSigner
+	models.MessageSigner
+	SignWithContext(ctx context.Context, msg []byte, txContext *TransactionContext) ([]byte, error)
+	SignMessageWithContext(ctx context.Context, message []byte) ([]byte, error)
+	Address() string
+	PublicKey() []byte
+}
@@ -0,0 +1,288 @@
+package remotesigner_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/core/remotesigner"
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+const (
+	testPrivateKey = "8734062c1158f26a3ca8a4a0da87b527a7c168653f7f4c77045e5cf571497d9d"
+	testAddress    = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+)
+
+type testCerts struct {
+	caFile, serverCert, serverKey, clientCert, clientKey string
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) string {
+	require.Nil(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return path
+}
+
+func issueCertificate(t *testing.T, dir, name string, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (string, string, *x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	certFile := writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	keyFile := writePEM(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyDER)
+
+	return certFile, keyFile, cert, key
+}
+
+func newTestCerts(t *testing.T) testCerts {
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour)
+
+	caFile, _, ca, caKey := issueCertificate(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+
+	serverCert, serverKey, _, _ := issueCertificate(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "signer"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+
+	clientCert, clientKey, _, _ := issueCertificate(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	return testCerts{caFile, serverCert, serverKey, clientCert, clientKey}
+}
+
+func newTestService(t *testing.T, policy remotesigner.Policy) (string, testCerts) {
+	w, err := wallet.NewWalletFroHex(testPrivateKey)
+	require.Nil(t, err)
+
+	handler, err := remotesigner.NewHandler(w, policy)
+	require.Nil(t, err)
+
+	certs := newTestCerts(t)
+	tlsConfig, err := remotesigner.NewServerTLSConfig(certs.serverCert, certs.serverKey, certs.caFile)
+	require.Nil(t, err)
+
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = tlsConfig
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server.URL, certs
+}
+
+func newTestSigner(t *testing.T, url string, certs testCerts) remotesigner.RemoteSigner {
+	tlsConfig, err := remotesigner.NewClientTLSConfig(certs.clientCert, certs.clientKey, certs.caFile)
+	require.Nil(t, err)
+
+	signer, err := remotesigner.NewRemoteSigner(remotesigner.Config{URL: url, Address: testAddress, TLSConfig: tlsConfig})
+	require.Nil(t, err)
+
+	return signer
+}
+
+func newTestTransaction(t *testing.T) *proto.Transaction {
+	w, err := wallet.NewWalletFroHex(testPrivateKey)
+	require.Nil(t, err)
+
+	tx := &proto.Transaction{RawData: &proto.Transaction_Raw{
+		Nonce:    9,
+		Sender:   w.PublicKey(),
+		ChainID:  []byte("100420"),
+		Contract: []*proto.TXContract{{Type: proto.TXContract_FreezeContractType}},
+		Data:     [][]byte{[]byte("memo")},
+	}}
+
+	_, hash, err := remotesigner.NewTransactionContext(tx)
+	require.Nil(t, err)
+	tx.Hash = hash
+
+	return tx
+}
+
+func TestRemoteSigner_Transaction_And_Message(t *testing.T) {
+	var requests []*remotesigner.SignRequest
+	url, certs := newTestService(t, func(r *remotesigner.SignRequest) error {
+		requests = append(requests, r)
+		return nil
+	})
+	signer := newTestSigner(t, url, certs)
+
+	tx := newTestTransaction(t)
+	require.Nil(t, tx.Sign(signer))
+	require.Len(t, tx.Signature, 1)
+	assert.True(t, ed25519.Verify(signer.PublicKey(), tx.Hash, tx.Signature[0]))
+
+	withContext := newTestTransaction(t)
+	require.Nil(t, signer.SignTransaction(context.Background(), withContext))
+	assert.Equal(t, tx.Signature, withContext.Signature)
+
+	require.Len(t, requests, 2)
+	for _, request := range requests {
+		txContext := request.Transaction
+		require.NotNil(t, txContext)
+		assert.Equal(t, testAddress, txContext.Sender)
+		assert.Equal(t, uint64(9), txContext.Nonce)
+		assert.Equal(t, []string{"FreezeContractType"}, txContext.ContractTypes)
+		assert.Equal(t, []string{"memo"}, txContext.Data)
+	}
+}
+
+func TestRemoteSigner_SignableMessage(t *testing.T) {
+	var requests []*remotesigner.SignRequest
+	url, certs := newTestService(t, func(r *remotesigner.SignRequest) error {
+		requests = append(requests, r)
+		return nil
+	})
+	signer := newTestSigner(t, url, certs)
+
+	sm := models.NewSM(testAddress, []byte("hello"))
+	require.Nil(t, sm.Sign(signer))
+	assert.True(t, sm.Verify())
+
+	require.Len(t, requests, 1)
+	assert.Nil(t, requests[0].Transaction)
+	assert.Equal(t, "68656c6c6f", requests[0].Message)
+
+	// same signature as a local wallet
+	w, err := wallet.NewWalletFroHex(testPrivateKey)
+	require.Nil(t, err)
+	local := models.NewSM(testAddress, []byte("hello"))
+	require.Nil(t, local.Sign(w))
+	assert.Equal(t, local.GetSignature(), sm.GetSignature())
+
+	loaded := models.NewSignableMessage()
+	require.Nil(t, loaded.LoadJSON(sm.ToJSON()))
+	assert.True(t, loaded.Verify())
+
+	// a transaction hash sent as a message is signed in the message domain only
+	tx := newTestTransaction(t)
+	signature, err := signer.SignMessage(tx.Hash)
+	require.Nil(t, err)
+	assert.False(t, ed25519.Verify(signer.PublicKey(), tx.Hash, signature))
+}
+
+func TestRemoteSigner_Refused(t *testing.T) {
+	url, certs := newTestService(t, func(r *remotesigner.SignRequest) error {
+		if r.Transaction == nil {
+			return fmt.Errorf("only transactions are signed")
+		}
+		return nil
+	})
+	signer := newTestSigner(t, url, certs)
+
+	err := models.NewSM(testAddress, []byte("message")).Sign(signer)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "only transactions are signed")
+
+	// hashes are never signed without the transaction they come from
+	_, err = signer.Sign(newTestTransaction(t).Hash)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "transaction context is required")
+
+	// the message must be the hash of the context
+	txContext, _, err := remotesigner.NewTransactionContext(newTestTransaction(t))
+	require.Nil(t, err)
+	_, err = signer.SignWithContext(context.Background(), []byte("other"), txContext)
+	assert.NotNil(t, err)
+
+	tx := newTestTransaction(t)
+	tx.Hash = []byte("wrong")
+	assert.NotNil(t, signer.SignTransaction(context.Background(), tx))
+
+	other, err := remotesigner.NewRemoteSigner(remotesigner.Config{
+		URL:       url,
+		Address:   "klv1velayazgrn6mqaqckt7utk9656h8zu3ex4ln8rx7n8p0vy4fd20qmwh4p5",
+		TLSConfig: newClientTLS(t, certs),
+	})
+	require.Nil(t, err)
+	assert.NotNil(t, other.SignTransaction(context.Background(), newTestTransaction(t)))
+}
+
+func newClientTLS(t *testing.T, certs testCerts) *tls.Config {
+	tlsConfig, err := remotesigner.NewClientTLSConfig(certs.clientCert, certs.clientKey, certs.caFile)
+	require.Nil(t, err)
+	return tlsConfig
+}
+
+func TestRemoteSigner_Requires_Client_Certificate(t *testing.T) {
+	url, certs := newTestService(t, nil)
+
+	tlsConfig := newClientTLS(t, certs)
+	tlsConfig.Certificates = nil
+
+	signer, err := remotesigner.NewRemoteSigner(remotesigner.Config{URL: url, Address: testAddress, TLSConfig: tlsConfig})
+	require.Nil(t, err)
+
+	_, err = signer.Sign([]byte("message"))
+	assert.NotNil(t, err)
+}
+
+func TestRemoteSigner_Verifies_Signature(t *testing.T) {
+	other, err := wallet.NewWallet(make([]byte, ed25519.SeedSize))
+	require.Nil(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature, _ := other.Sign([]byte("message"))
+		_, _ = fmt.Fprintf(w, `{"data": {"signature": "%x"}, "code": "successful"}`, signature)
+	}))
+	t.Cleanup(server.Close)
+
+	signer, err := remotesigner.NewRemoteSigner(remotesigner.Config{URL: server.URL, Address: testAddress})
+	require.Nil(t, err)
+
+	_, err = signer.Sign([]byte("message"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "doesn't match")
+
+	_, err = remotesigner.NewRemoteSigner(remotesigner.Config{URL: server.URL, Address: "invalid"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,123 @@
+package remotesigner
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/tools/hasher"
+	"github.com/klever-io/klever-go-sdk/provider/tools/marshal"
+)
+
+const (
+	// SignPath is the endpoint signing transaction hashes, the transaction context is required
+	SignPath = "/sign"
+	// SignMessagePath is the endpoint signing messages as SignableMessage does,
+	// so a message signature can never be used as a transaction signature
+	SignMessagePath = "/sign-message"
+)
+
+// SignRequest is the body sent to the signing service, Message is hex encoded. On SignPath it is the
+// transaction hash described by Transaction, on SignMessagePath it is the message itself
+type SignRequest struct {
+	Address     string              `json:"address"`
+	Message     string              `json:"message"`
+	Transaction *TransactionContext `json:"transaction,omitempty"`
+}
+
+// SignResponse is the signing service answer, Signature is hex encoded
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// TransactionContext is the decoded transaction sent along its hash, so the
+// signing service can check what it signs. RawData is the hex encoded proto raw data
+type TransactionContext struct {
+	RawData       string   `json:"rawData"`
+	Sender        string   `json:"sender"`
+	Nonce         uint64   `json:"nonce"`
+	PermissionID  int32    `json:"permissionID"`
+	ChainID       string   `json:"chainID"`
+	ContractTypes []string `json:"contractTypes"`
+	KAppFee       int64    `json:"kAppFee"`
+	BandwidthFee  int64    `json:"bandwidthFee"`
+	Data          []string `json:"data,omitempty"`
+}
+
+// NewTransactionContext decodes the raw data of tx and returns it with the transaction hash
+func NewTransactionContext(tx *proto.Transaction) (*TransactionContext, []byte, error) {
+	raw := tx.GetRawData()
+	if raw == nil {
+		return nil, nil, fmt.Errorf("transaction without raw data")
+	}
+
+	rawBytes, err := marshal.NewProtoMarshalizer().Marshal(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sender, err := address.NewAddressFromBytes(raw.GetSender())
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid sender: %w", err)
+	}
+
+	txContext := &TransactionContext{
+		RawData:      hex.EncodeToString(rawBytes),
+		Sender:       sender.Bech32(),
+		Nonce:        raw.GetNonce(),
+		PermissionID: raw.GetPermissionID(),
+		ChainID:      string(raw.GetChainID()),
+		KAppFee:      raw.GetKAppFee(),
+		BandwidthFee: raw.GetBandwidthFee(),
+	}
+
+	for _, contract := range raw.GetContract() {
+		txContext.ContractTypes = append(txContext.ContractTypes, contract.GetType().String())
+	}
+
+	for _, data := range raw.GetData() {
+		txContext.Data = append(txContext.Data, string(data))
+	}
+
+	hash, err := hashRawData(rawBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txContext, hash, nil
+}
+
+// Hash returns the hash of RawData, the message signed for the transaction
+func (c *TransactionContext) Hash() ([]byte, error) {
+	rawBytes, err := hex.DecodeString(c.RawData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid raw data: %w", err)
+	}
+
+	return hashRawData(rawBytes)
+}
+
+// Decode returns the proto raw data of the transaction
+func (c *TransactionContext) Decode() (*proto.Transaction_Raw, error) {
+	rawBytes, err := hex.DecodeString(c.RawData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid raw data: %w", err)
+	}
+
+	raw := &proto.Transaction_Raw{}
+	if err := marshal.NewProtoMarshalizer().Unmarshal(raw, rawBytes); err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+func hashRawData(rawBytes []byte) ([]byte, error) {
+	h, err := hasher.NewHasher()
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Compute(string(rawBytes)), nil
+}
@@ -0,0 +1,112 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+// DerivedWallet is a wallet derived from a mnemonic with the path used
+type DerivedWallet struct {
+	Wallet
+	Path    HDPath
+	Address string
+	// Account is only set by ScanAccounts
+	Account *models.Account
+}
+
+// AccountGetter reads accounts from the chain, it is implemented by provider.KleverChain
+type AccountGetter interface {
+	GetAccountWithContext(ctx context.Context, address string) (*models.Account, error)
+}
+
+// DeriveWallets derives `accounts` x `indexes` wallets starting at the account and index of `from`,
+// ordered by account and then by index
+func DeriveWallets(mnemonic string, from HDPath, accounts int, indexes int) ([]*DerivedWallet, error) {
+	if accounts <= 0 || indexes <= 0 {
+		return nil, fmt.Errorf("invalid derivation range %d accounts x %d indexes", accounts, indexes)
+	}
+
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+
+	wallets := make([]*DerivedWallet, 0, accounts*indexes)
+	for a := 0; a < accounts; a++ {
+		for i := 0; i < indexes; i++ {
+			path := from
+			path.Account += a
+			path.Index += i
+
+			w, err := deriveWallet(mnemonic, path)
+			if err != nil {
+				return nil, err
+			}
+
+			wallets = append(wallets, w)
+		}
+	}
+
+	return wallets, nil
+}
+
+// ScanAccounts derives the indexes of the account of `from` and returns the ones with on-chain
+// activity, the scan stops after `gapLimit` consecutive addresses without activity
+func ScanAccounts(ctx context.Context, getter AccountGetter, mnemonic string, from HDPath, gapLimit int) ([]*DerivedWallet, error) {
+	if gapLimit <= 0 {
+		return nil, fmt.Errorf("invalid gap limit %d", gapLimit)
+	}
+
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+
+	found := make([]*DerivedWallet, 0)
+	for gap, path := 0, from; gap < gapLimit; path.Index++ {
+		w, err := deriveWallet(mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+
+		acc, err := getter.GetAccountWithContext(ctx, w.Address)
+		if err != nil && !errors.Is(err, utils.ErrNotFound) {
+			return nil, fmt.Errorf("error scanning %s: %w", w.Address, err)
+		}
+
+		if !hasActivity(acc) {
+			gap++
+			continue
+		}
+
+		gap = 0
+		w.Account = acc
+		found = append(found, w)
+	}
+
+	return found, nil
+}
+
+func deriveWallet(mnemonic string, path HDPath) (*DerivedWallet, error) {
+	w, err := NewWalletFromMnemonicPath(mnemonic, path)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := w.GetAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DerivedWallet{Wallet: w, Path: path, Address: acc.Address().Bech32()}, nil
+}
+
+func hasActivity(acc *models.Account) bool {
+	if acc == nil || acc.AccountInfo == nil {
+		return false
+	}
+
+	return acc.Nonce > 0 || acc.Balance > 0 || acc.FrozenBalance > 0 || len(acc.Assets) > 0
+}
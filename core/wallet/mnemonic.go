@@ -0,0 +1,48 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+)
+
+// GenerateMnemonic returns a new random BIP39 mnemonic of 12, 15, 18, 21 or 24 words
+func GenerateMnemonic(words int) (string, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", fmt.Errorf("invalid mnemonic length %d, expected 12, 15, 18, 21 or 24 words", words)
+	}
+
+	// each 3 words hold 32 bits of entropy and 1 bit of checksum
+	entropy, err := bip39.NewEntropy(words / 3 * 32)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// ValidateMnemonic checks the words and the checksum of a BIP39 mnemonic
+func ValidateMnemonic(mnemonic string) error {
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return fmt.Errorf("invalid mnemonic length %d", len(words))
+	}
+
+	if _, err := bip39.MnemonicToByteArray(strings.Join(words, " ")); err != nil {
+		return fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	return nil
+}
+
+func (p HDPath) path() string {
+	path := strings.Replace(HDPrefix, "m/", "", 1)
+
+	return fmt.Sprintf(path, p.Prefix, p.Account, p.Index)
+}
+
+// String returns the derivation path, e.g. m/44'/690'/0'/0'/0'
+func (p HDPath) String() string {
+	return fmt.Sprintf(HDPrefix, p.Prefix, p.Account, p.Index)
+}
@@ -0,0 +1,120 @@
+package wallet_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestGenerateMnemonic(t *testing.T) {
+	for _, words := range []int{12, 24} {
+		mnemonic, err := wallet.GenerateMnemonic(words)
+		require.Nil(t, err)
+		assert.Len(t, strings.Fields(mnemonic), words)
+		assert.Nil(t, wallet.ValidateMnemonic(mnemonic))
+	}
+
+	_, err := wallet.GenerateMnemonic(13)
+	assert.NotNil(t, err)
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	assert.Nil(t, wallet.ValidateMnemonic(testMnemonic))
+
+	// wrong checksum word
+	assert.NotNil(t, wallet.ValidateMnemonic(strings.Replace(testMnemonic, "about", "abandon", 1)))
+	assert.NotNil(t, wallet.ValidateMnemonic(strings.Replace(testMnemonic, "about", "klever", 1)))
+	assert.NotNil(t, wallet.ValidateMnemonic("abandon about"))
+}
+
+func TestWallet_Mnemonic_Account_And_Passphrase(t *testing.T) {
+	defaultPath, err := wallet.NewWalletFromMnemonic(testMnemonic)
+	require.Nil(t, err)
+
+	explicit, err := wallet.NewWalletFromMnemonicPath(testMnemonic, wallet.NewHDPath(0, 0))
+	require.Nil(t, err)
+	assert.Equal(t, defaultPath.PublicKey(), explicit.PublicKey())
+
+	positional, err := wallet.NewWalletFromMnemonic(testMnemonic, wallet.WOHDPath{690, 1})
+	require.Nil(t, err)
+	index, err := wallet.NewWalletFromMnemonicPath(testMnemonic, wallet.NewHDPath(0, 1))
+	require.Nil(t, err)
+	assert.Equal(t, positional.PublicKey(), index.PublicKey())
+	assert.NotEqual(t, defaultPath.PublicKey(), index.PublicKey())
+
+	account, err := wallet.NewWalletFromMnemonicPath(testMnemonic, wallet.NewHDPath(1, 0))
+	require.Nil(t, err)
+	assert.NotEqual(t, defaultPath.PublicKey(), account.PublicKey())
+
+	passphrase, err := wallet.NewWalletFromMnemonicPath(testMnemonic, wallet.HDPath{Prefix: wallet.DefaultHDPrefix, Passphrase: "klever"})
+	require.Nil(t, err)
+	assert.NotEqual(t, defaultPath.PublicKey(), passphrase.PublicKey())
+
+	assert.Equal(t, "m/44'/690'/1'/0'/2'", wallet.NewHDPath(1, 2).String())
+}
+
+func TestDeriveWallets(t *testing.T) {
+	wallets, err := wallet.DeriveWallets(testMnemonic, wallet.NewHDPath(0, 1), 2, 3)
+	require.Nil(t, err)
+	require.Len(t, wallets, 6)
+
+	last := wallets[5]
+	assert.Equal(t, wallet.NewHDPath(1, 3), last.Path)
+
+	expected, err := wallet.NewWalletFromMnemonicPath(testMnemonic, last.Path)
+	require.Nil(t, err)
+	assert.Equal(t, expected.PublicKey(), last.PublicKey())
+
+	_, err = wallet.DeriveWallets(testMnemonic, wallet.HDPath{}, 0, 1)
+	assert.NotNil(t, err)
+
+	_, err = wallet.DeriveWallets("abandon about", wallet.HDPath{}, 1, 1)
+	assert.NotNil(t, err)
+}
+
+type fakeAccountGetter struct {
+	accounts map[string]*models.Account
+	calls    int
+}
+
+func (f *fakeAccountGetter) GetAccountWithContext(_ context.Context, address string) (*models.Account, error) {
+	f.calls++
+	if acc, exists := f.accounts[address]; exists {
+		return acc, nil
+	}
+
+	return nil, utils.ErrNotFound
+}
+
+func TestScanAccounts(t *testing.T) {
+	from := wallet.NewHDPath(0, 0)
+	derived, err := wallet.DeriveWallets(testMnemonic, from, 1, 6)
+	require.Nil(t, err)
+
+	getter := &fakeAccountGetter{accounts: map[string]*models.Account{
+		derived[0].Address: {AccountInfo: &models.AccountInfo{Address: derived[0].Address, Nonce: 3}},
+		derived[1].Address: {AccountInfo: &models.AccountInfo{Address: derived[1].Address}},
+		derived[2].Address: {AccountInfo: &models.AccountInfo{Address: derived[2].Address, Balance: 10}},
+		// beyond the gap limit
+		derived[5].Address: {AccountInfo: &models.AccountInfo{Address: derived[5].Address, Balance: 10}},
+	}}
+
+	found, err := wallet.ScanAccounts(context.Background(), getter, testMnemonic, from, 2)
+	require.Nil(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, derived[0].Address, found[0].Address)
+	assert.Equal(t, derived[2].Address, found[1].Address)
+	assert.Equal(t, int64(10), found[1].Account.Balance)
+	assert.Equal(t, 5, getter.calls)
+
+	_, err = wallet.ScanAccounts(context.Background(), getter, testMnemonic, from, 0)
+	assert.NotNil(t, err)
+}
@@ -19,6 +19,102 @@ import (
 	"github.com/xdg-go/pbkdf2"
 )
 
+const pemBlockPrefix = "PRIVATE KEY for "
+
+// EncodePEMBlock returns the `PRIVATE KEY for <address>` block of the private key read by LoadKey,
+// encrypted with `pwd` when it isn't empty
+func EncodePEMBlock(privateKey []byte, pwd string) (*pem.Block, error) {
+	w, err := NewWallet(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := w.GetAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	blockType := pemBlockPrefix + acc.Address().Bech32()
+	data := []byte(hex.EncodeToString(privateKey))
+
+	if len(pwd) == 0 {
+		return &pem.Block{Type: blockType, Bytes: data}, nil
+	}
+
+	return EncryptPEMBlock(blockType, data, pwd)
+}
+
+// EncodePEM returns a PEM file holding the private keys in order, each one
+// can be loaded by LoadKey with its index
+func EncodePEM(pwd string, privateKeys ...[]byte) ([]byte, error) {
+	if len(privateKeys) == 0 {
+		return nil, fmt.Errorf("no keys to encode")
+	}
+
+	var buff []byte
+	for i, privateKey := range privateKeys {
+		block, err := EncodePEMBlock(privateKey, pwd)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %d", err, i)
+		}
+
+		buff = append(buff, pem.EncodeToMemory(block)...)
+	}
+
+	return buff, nil
+}
+
+// SaveKey writes a new PEM file with the private keys, readable only by its owner.
+// It fails if the file already exists
+func SaveKey(path string, pwd string, privateKeys ...[]byte) error {
+	buff, err := EncodePEM(pwd, privateKeys...)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filepath.Clean(path), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(buff); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return err
+	}
+
+	return file.Close()
+}
+
+// WritePEMFile replaces the file with the blocks atomically, readable only by its owner
+func WritePEMFile(path string, blocks ...*pem.Block) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".pem-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	for _, block := range blocks {
+		if err := pem.Encode(tmp, block); err != nil {
+			_ = tmp.Close()
+			return err
+		}
+	}
+
+	if err := tmp.Chmod(0600); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
 func LoadKey(pemFile string, skIndex int, pwd string) ([]byte, string, error) {
 
 	encodedSk, pkString, err := LoadSkPkFromPemFile(pemFile, skIndex, pwd)
@@ -86,7 +182,7 @@ func LoadSkPkFromPemFile(relativePath string, skIndex int, pwd string) ([]byte,
 	}
 
 	blockType := blkRecovered.Type
-	header := "PRIVATE KEY for "
+	header := pemBlockPrefix
 	if strings.Index(blockType, header) != 0 {
 		return nil, "", fmt.Errorf("pem file is invalid missing '%s' in block type", header)
 	}
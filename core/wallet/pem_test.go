@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"log"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/klever-io/klever-go-sdk/core/wallet"
@@ -184,3 +185,52 @@ func TestEncryptPEMBlock_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, pemBlock.Bytes, 92)
 }
+
+func TestSaveKey_RoundTrip(t *testing.T) {
+	first, _ := hex.DecodeString("8734062c1158f26a3ca8a4a0da87b527a7c168653f7f4c77045e5cf571497d9d")
+	second := make([]byte, 32)
+	second[0] = 1
+
+	for _, pwd := range []string{"", "1234"} {
+		path := filepath.Join(t.TempDir(), "keys.pem")
+		assert.Nil(t, wallet.SaveKey(path, pwd, first, second))
+
+		stat, err := os.Stat(path)
+		assert.Nil(t, err)
+		assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+		sk, pk, err := wallet.LoadKey(path, 0, pwd)
+		assert.Nil(t, err)
+		assert.Equal(t, first, sk)
+		assert.Equal(t, "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy", pk)
+
+		sk, _, err = wallet.LoadKey(path, 1, pwd)
+		assert.Nil(t, err)
+		assert.Equal(t, second, sk)
+
+		_, _, err = wallet.LoadKey(path, 2, pwd)
+		assert.NotNil(t, err)
+
+		assert.NotNil(t, wallet.SaveKey(path, pwd, first))
+	}
+}
+
+func TestEncodePEM_NewWalletFromPEM(t *testing.T) {
+	sk, _ := hex.DecodeString("8734062c1158f26a3ca8a4a0da87b527a7c168653f7f4c77045e5cf571497d9d")
+
+	buff, err := wallet.EncodePEM("", sk)
+	assert.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "wallet.pem")
+	assert.Nil(t, os.WriteFile(path, buff, 0600))
+
+	w, err := wallet.NewWalletFromPEM(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "e41b323a571fd955e09cd41660ff4465c3f44693c87f2faea4a0fc408727c8ea", hex.EncodeToString(w.PublicKey()))
+
+	_, err = wallet.EncodePEM("")
+	assert.NotNil(t, err)
+
+	_, err = wallet.EncodePEM("", []byte{1, 2})
+	assert.NotNil(t, err)
+}
@@ -39,16 +39,19 @@ func NewWallet(privateKey []byte) (Wallet, error) {
 
 func NewWalletFromMnemonic(mnemonic string, option ...WOHDPath) (Wallet, error) {
 	if len(option) == 0 {
-		option = []WOHDPath{{690, 0}}
+		option = []WOHDPath{{DefaultHDPrefix, 0}}
 	}
 	if len(option) != 1 {
 		return nil, fmt.Errorf("invalid options")
 	}
 
-	path := strings.Replace(HDPrefix, "m/", "", 1)
-	path = fmt.Sprintf(path, option[0].Prefix, 0, option[0].Index)
+	return NewWalletFromMnemonicPath(mnemonic, HDPath{Prefix: option[0].Prefix, Index: option[0].Index})
+}
 
-	private, err := deriveFromPath(mnemonic, path, "")
+// NewWalletFromMnemonicPath derives the wallet at `path`, it allows choosing the account
+// and the BIP39 passphrase that NewWalletFromMnemonic always leaves as zero and empty
+func NewWalletFromMnemonicPath(mnemonic string, path HDPath) (Wallet, error) {
+	private, err := deriveFromPath(mnemonic, path.path(), path.Passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,23 @@
 package wallet
 
+// DefaultHDPrefix is the Klever coin type used in the derivation path
+const DefaultHDPrefix = 690
+
 type WOHDPath struct {
 	Prefix int
 	Index  int
 }
+
+// HDPath selects the key derived from a mnemonic at m/44'/Prefix'/Account'/0'/Index',
+// Passphrase is the optional BIP39 passphrase
+type HDPath struct {
+	Prefix     int
+	Account    int
+	Index      int
+	Passphrase string
+}
+
+// NewHDPath returns the path of `index` in `account` with the Klever coin type
+func NewHDPath(account, index int) HDPath {
+	return HDPath{Prefix: DefaultHDPrefix, Account: account, Index: index}
+}
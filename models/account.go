@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -43,6 +44,42 @@ type Permissions struct {
 	Signers        []PermissionKey `json:"signers"`
 }
 
+// GetPermission returns the account permission with the given id. Accounts that never
+// set permissions are controlled by their own key, permission 0 is then its owner permission
+func (acc *Account) GetPermission(id int32) (*Permissions, error) {
+	if acc == nil || acc.AccountInfo == nil {
+		return nil, fmt.Errorf("account info not loaded")
+	}
+
+	if id == 0 && len(acc.Permissions) == 0 {
+		return &Permissions{
+			ID:        0,
+			Type:      PermissionTypeOwner,
+			Threshold: 1,
+			Signers:   []PermissionKey{{Address: acc.Address, Weight: 1}},
+		}, nil
+	}
+
+	for i := range acc.Permissions {
+		if acc.Permissions[i].ID == id {
+			return &acc.Permissions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("permission %d not found for account %s", id, acc.Address)
+}
+
+// SignerWeight returns the weight of `address` in the permission, zero if it isn't a signer
+func (p *Permissions) SignerWeight(address string) int64 {
+	for _, signer := range p.Signers {
+		if signer.Address == address {
+			return signer.Weight
+		}
+	}
+
+	return 0
+}
+
 func (acc *Account) String() string {
 	result, err := json.MarshalIndent(acc, "", "\t")
 	if err != nil {
@@ -29,3 +29,27 @@ func TestAccountAlloance_ToString(t *testing.T) {
 		acc.String(),
 	)
 }
+
+func TestAccount_GetPermission(t *testing.T) {
+	acc := &models.Account{
+		AccountInfo: &models.AccountInfo{
+			Address: "1234",
+			Permissions: []models.Permissions{
+				{ID: 0, Threshold: 1},
+				{ID: 3, Threshold: 2, Signers: []models.PermissionKey{{Address: "abcd", Weight: 2}}},
+			},
+		},
+	}
+
+	permission, err := acc.GetPermission(3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), permission.Threshold)
+	assert.Equal(t, int64(2), permission.SignerWeight("abcd"))
+	assert.Equal(t, int64(0), permission.SignerWeight("1234"))
+
+	_, err = acc.GetPermission(1)
+	assert.NotNil(t, err)
+
+	_, err = (&models.Account{}).GetPermission(0)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Amount is an exact decimal value stored as integer units of its precision,
+// e.g. 0.29 with precision 6 is kept as 290000 units
+type Amount struct {
+	units     *big.Int
+	precision uint32
+}
+
+// NewAmount creates an amount from integer units already scaled to precision, nil units are zero
+func NewAmount(units *big.Int, precision uint32) Amount {
+	amount := Amount{
+		units:     new(big.Int),
+		precision: precision,
+	}
+
+	if units != nil {
+		amount.units.Set(units)
+	}
+
+	return amount
+}
+
+// NewAmountFromInt64 creates an amount from integer units already scaled to precision
+func NewAmountFromInt64(units int64, precision uint32) Amount {
+	return NewAmount(big.NewInt(units), precision)
+}
+
+// ParseAmount parses a decimal string, the precision is the number of decimals provided
+func ParseAmount(s string) (Amount, error) {
+	_, decimals, _ := strings.Cut(strings.TrimSpace(s), ".")
+
+	return ParseAmountWithPrecision(s, uint32(len(decimals)))
+}
+
+// ParseAmountWithPrecision parses a decimal string into units of the given precision,
+// amounts with more decimals than the precision are rejected
+func ParseAmountWithPrecision(s string, precision uint32) (Amount, error) {
+	s = strings.TrimSpace(s)
+	integer, decimals, hasDot := strings.Cut(s, ".")
+
+	if len(integer) == 0 && len(decimals) == 0 {
+		return Amount{}, fmt.Errorf("invalid amount `%s`", s)
+	}
+
+	if hasDot && len(decimals) == 0 {
+		return Amount{}, fmt.Errorf("invalid amount `%s`", s)
+	}
+
+	if !isDigits(integer) || !isDigits(decimals) {
+		return Amount{}, fmt.Errorf("invalid amount `%s`", s)
+	}
+
+	if len(decimals) > int(precision) {
+		return Amount{}, fmt.Errorf("amount `%s` has more than %d decimals", s, precision)
+	}
+
+	digits := integer + decimals + strings.Repeat("0", int(precision)-len(decimals))
+	units, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Amount{}, fmt.Errorf("invalid amount `%s`", s)
+	}
+
+	return Amount{units: units, precision: precision}, nil
+}
+
+func isDigits(s string) bool {
+	for _, ch := range []byte(s) {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Units returns a copy of the integer units of the amount
+func (a Amount) Units() *big.Int {
+	if a.units == nil {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Set(a.units)
+}
+
+// Precision returns the number of decimals of the amount
+func (a Amount) Precision() uint32 {
+	return a.precision
+}
+
+// ToUnits converts the amount to integer units of the given precision,
+// failing if decimals would be lost or if the result overflows int64
+func (a Amount) ToUnits(precision uint32) (int64, error) {
+	units := a.Units()
+
+	if precision >= a.precision {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision-a.precision)), nil)
+		units.Mul(units, scale)
+	} else {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(a.precision-precision)), nil)
+		remainder := new(big.Int)
+		units.QuoRem(units, scale, remainder)
+
+		if remainder.Sign() != 0 {
+			return 0, fmt.Errorf("amount %s has more than %d decimals", a.String(), precision)
+		}
+	}
+
+	if !units.IsInt64() {
+		return 0, fmt.Errorf("amount %s overflows int64 with precision %d", a.String(), precision)
+	}
+
+	return units.Int64(), nil
+}
+
+// String formats the amount as a decimal without trailing zeros
+func (a Amount) String() string {
+	units := a.Units()
+
+	sign := ""
+	if units.Sign() < 0 {
+		sign = "-"
+	}
+
+	digits := units.Abs(units).String()
+	if a.precision == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= int(a.precision) {
+		digits = strings.Repeat("0", int(a.precision)-len(digits)+1) + digits
+	}
+
+	integer := digits[:len(digits)-int(a.precision)]
+	decimals := strings.TrimRight(digits[len(digits)-int(a.precision):], "0")
+
+	if len(decimals) == 0 {
+		return sign + integer
+	}
+
+	return sign + integer + "." + decimals
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (a *Amount) UnmarshalText(text []byte) error {
+	parsed, err := ParseAmount(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+
+	return nil
+}
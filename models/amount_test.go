@@ -0,0 +1,110 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmount_ParseAndFormat(t *testing.T) {
+	testCases := []struct {
+		input     string
+		precision uint32
+		units     int64
+		formatted string
+	}{
+		{"0.29", 6, 290000, "0.29"},
+		{"1", 6, 1000000, "1"},
+		{"123.456789", 6, 123456789, "123.456789"},
+		{".5", 2, 50, "0.5"},
+		{"0.000001", 6, 1, "0.000001"},
+		{"42", 0, 42, "42"},
+	}
+
+	for _, tc := range testCases {
+		amount, err := models.ParseAmountWithPrecision(tc.input, tc.precision)
+		require.Nil(t, err, tc.input)
+
+		units, err := amount.ToUnits(tc.precision)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.units, units, tc.input)
+		assert.Equal(t, tc.formatted, amount.String())
+	}
+}
+
+func TestAmount_ToUnitsRescale(t *testing.T) {
+	amount, err := models.ParseAmount("0.29")
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), amount.Precision())
+
+	units, err := amount.ToUnits(6)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(290000), units)
+
+	_, err = amount.ToUnits(1)
+	assert.Contains(t, err.Error(), "has more than 1 decimals")
+
+	units, err = models.NewAmountFromInt64(1500, 3).ToUnits(1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), units)
+}
+
+func TestAmount_Negative(t *testing.T) {
+	assert.Equal(t, "-0.05", models.NewAmountFromInt64(-5, 2).String())
+	assert.Equal(t, "-1.5", models.NewAmountFromInt64(-150, 2).String())
+	assert.Equal(t, "-7", models.NewAmountFromInt64(-7, 0).String())
+	assert.Equal(t, "-2", models.NewAmountFromInt64(-2000, 3).String())
+
+	units, err := models.NewAmountFromInt64(-5, 2).ToUnits(6)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-50000), units)
+}
+
+func TestAmount_NilUnits(t *testing.T) {
+	amount := models.NewAmount(nil, 6)
+	assert.Equal(t, "0", amount.String())
+	assert.Equal(t, big.NewInt(0), amount.Units())
+
+	units, err := amount.ToUnits(2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), units)
+
+	// the amount doesn't share the units of the caller
+	source := big.NewInt(10)
+	amount = models.NewAmount(source, 1)
+	source.SetInt64(20)
+	assert.Equal(t, "1", amount.String())
+}
+
+func TestAmount_Errors(t *testing.T) {
+	_, err := models.ParseAmountWithPrecision("1.1234567", 6)
+	assert.Contains(t, err.Error(), "has more than 6 decimals")
+
+	for _, invalid := range []string{"", ".", "1.", "-1", "1e6", "1,5", "abc"} {
+		_, err = models.ParseAmountWithPrecision(invalid, 6)
+		assert.NotNil(t, err, invalid)
+	}
+
+	huge, err := models.ParseAmount("9223372036854.775808")
+	require.Nil(t, err)
+	_, err = huge.ToUnits(6)
+	assert.Contains(t, err.Error(), "overflows int64")
+}
+
+func TestAmount_JSON(t *testing.T) {
+	value := struct {
+		Amount models.Amount `json:"amount"`
+	}{}
+
+	err := json.Unmarshal([]byte(`{"amount":"10.05"}`), &value)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1005), value.Amount.Units())
+
+	data, err := json.Marshal(value)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"amount":"10.05"}`, string(data))
+}
@@ -71,3 +71,13 @@ type ParsedItemPack struct {
 	Amount float64 `form:"amount" json:"amount"`
 	Price  float64 `form:"price" json:"price"`
 }
+
+type ParsedExactPack struct {
+	Kda   string                `form:"kda" json:"kda"`
+	Packs []ParsedExactItemPack `form:"packs" json:"packs"`
+}
+
+type ParsedExactItemPack struct {
+	Amount Amount `form:"amount" json:"amount"`
+	Price  Amount `form:"price" json:"price"`
+}
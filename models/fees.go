@@ -0,0 +1,36 @@
+package models
+
+// KDAFeeEstimate is the fee charged in a KDA other than KLV
+type KDAFeeEstimate struct {
+	KDA    string `json:"kda"`
+	Amount int64  `json:"amount"`
+}
+
+// FeesEstimate is the cost the node expects to charge for a transaction,
+// ReturnData items of smart contract calls are base64 encoded
+type FeesEstimate struct {
+	KAppFee       int64           `json:"kAppFee"`
+	BandwidthFee  int64           `json:"bandwidthFee"`
+	KDAFee        *KDAFeeEstimate `json:"kdaFee,omitempty"`
+	GasUsed       uint64          `json:"gasUsed"`
+	GasMultiplier uint64          `json:"gasMultiplier"`
+	// SafetyMargin is the percentage the node suggests to add to GasUsed
+	SafetyMargin  uint64   `json:"safetyMargin"`
+	ReturnData    []string `json:"returnData"`
+	ReturnMessage string   `json:"returnMessage"`
+}
+
+// TotalFee is the KLV amount of kApp and bandwidth fees
+func (f *FeesEstimate) TotalFee() int64 {
+	return f.KAppFee + f.BandwidthFee
+}
+
+// SuggestedGasLimit is GasUsed with the safety margin applied
+func (f *FeesEstimate) SuggestedGasLimit() uint64 {
+	return f.GasUsed + f.GasUsed*f.SafetyMargin/100
+}
+
+// HexReturnData returns the return data items hex encoded
+func (f *FeesEstimate) HexReturnData() ([]string, error) {
+	return hexReturnData(f.ReturnData)
+}
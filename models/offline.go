@@ -0,0 +1,11 @@
+package models
+
+// OfflineTXOptions holds the network dependent fields that the node fills
+// when preparing a transaction, so they can be supplied without a node call
+type OfflineTXOptions struct {
+	ChainID      string
+	Version      uint32
+	KAppFee      int64
+	BandwidthFee int64
+	KDAFeeAmount int64
+}
@@ -0,0 +1,280 @@
+package models
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+// Permission types
+const (
+	PermissionTypeOwner int32 = iota
+	PermissionTypeUser
+)
+
+// LengthOfOperations is the size of the permission operations mask, one bit per contract type
+const LengthOfOperations = 32
+
+// PermissionOperations is the mask of contract types a permission is allowed to sign. The node reads
+// the permission operations as a big-endian integer and allows the contract type `t` when its bit `t`
+// is set, the bit of `t` is then `1 << (t % 8)` of the byte `LengthOfOperations - 1 - t / 8`
+type PermissionOperations [LengthOfOperations]byte
+
+// operationBit returns the byte index and the bit of the contract type in the mask
+func operationBit(contractType proto.TXContract_ContractType) (int, byte) {
+	return LengthOfOperations - 1 - int(contractType/8), 1 << (contractType % 8)
+}
+
+// NewPermissionOperations returns the mask allowing `contractTypes`
+func NewPermissionOperations(contractTypes ...proto.TXContract_ContractType) (PermissionOperations, error) {
+	var operations PermissionOperations
+	err := operations.Add(contractTypes...)
+
+	return operations, err
+}
+
+// ParsePermissionOperations reads a hex encoded operations mask, as returned by
+// the API or set on PermissionTXRequest. Masks shorter than 32 bytes are accepted,
+// as a big-endian integer they hold the lowest contract types
+func ParsePermissionOperations(hexMask string) (PermissionOperations, error) {
+	var operations PermissionOperations
+
+	b, err := hex.DecodeString(strings.TrimPrefix(hexMask, "0x"))
+	if err != nil {
+		return operations, fmt.Errorf("invalid operations %q: %w", hexMask, err)
+	}
+
+	if len(b) > LengthOfOperations {
+		return operations, fmt.Errorf("invalid operations length %d", len(b))
+	}
+
+	copy(operations[LengthOfOperations-len(b):], b)
+
+	return operations, nil
+}
+
+// Add allows `contractTypes`
+func (o *PermissionOperations) Add(contractTypes ...proto.TXContract_ContractType) error {
+	for _, t := range contractTypes {
+		if t < 0 || int(t) >= LengthOfOperations*8 {
+			return fmt.Errorf("invalid contract type %d", t)
+		}
+
+		i, bit := operationBit(t)
+		o[i] |= bit
+	}
+
+	return nil
+}
+
+// Remove disallows `contractTypes`
+func (o *PermissionOperations) Remove(contractTypes ...proto.TXContract_ContractType) {
+	for _, t := range contractTypes {
+		if t >= 0 && int(t) < LengthOfOperations*8 {
+			i, bit := operationBit(t)
+			o[i] &^= bit
+		}
+	}
+}
+
+// Has reports whether the contract type is allowed
+func (o PermissionOperations) Has(contractType proto.TXContract_ContractType) bool {
+	if contractType < 0 || int(contractType) >= LengthOfOperations*8 {
+		return false
+	}
+
+	i, bit := operationBit(contractType)
+
+	return o[i]&bit != 0
+}
+
+// ContractTypes returns the allowed contract types in ascending order
+func (o PermissionOperations) ContractTypes() []proto.TXContract_ContractType {
+	contractTypes := make([]proto.TXContract_ContractType, 0)
+	for t := proto.TXContract_ContractType(0); int(t) < LengthOfOperations*8; t++ {
+		if o.Has(t) {
+			contractTypes = append(contractTypes, t)
+		}
+	}
+
+	return contractTypes
+}
+
+// IsEmpty reports whether no contract type is allowed
+func (o PermissionOperations) IsEmpty() bool {
+	return o == PermissionOperations{}
+}
+
+// String returns the hex encoded mask, the format of PermissionTXRequest.Operations
+func (o PermissionOperations) String() string {
+	return hex.EncodeToString(o[:])
+}
+
+// GetOperations parses the operations of the permission
+func (p *Permissions) GetOperations() (PermissionOperations, error) {
+	return ParsePermissionOperations(p.Operations)
+}
+
+// SetOperations replaces the permission operations by `contractTypes`
+func (p *PermissionTXRequest) SetOperations(contractTypes ...proto.TXContract_ContractType) error {
+	operations, err := NewPermissionOperations(contractTypes...)
+	if err != nil {
+		return err
+	}
+
+	p.Operations = operations.String()
+
+	return nil
+}
+
+// Validate checks the permission before it is sent: signer addresses must be valid
+// and unique, weights positive and their sum must reach the threshold
+func (p *PermissionTXRequest) Validate() error {
+	if p.Type != PermissionTypeOwner && p.Type != PermissionTypeUser {
+		return fmt.Errorf("invalid permission type %d", p.Type)
+	}
+
+	if p.Threshold <= 0 {
+		return fmt.Errorf("permission %s threshold must be positive", p.PermissionName)
+	}
+
+	if len(p.Signers) == 0 {
+		return fmt.Errorf("permission %s has no signers", p.PermissionName)
+	}
+
+	seen := make(map[string]struct{}, len(p.Signers))
+	var totalWeight int64
+	for _, signer := range p.Signers {
+		if _, err := address.NewAddress(signer.Address); err != nil {
+			return fmt.Errorf("permission %s has an invalid signer %s: %w", p.PermissionName, signer.Address, err)
+		}
+
+		if _, exists := seen[signer.Address]; exists {
+			return fmt.Errorf("permission %s has the signer %s repeated", p.PermissionName, signer.Address)
+		}
+		seen[signer.Address] = struct{}{}
+
+		if signer.Weight <= 0 {
+			return fmt.Errorf("permission %s signer %s weight must be positive", p.PermissionName, signer.Address)
+		}
+
+		totalWeight += signer.Weight
+	}
+
+	if totalWeight < p.Threshold {
+		return fmt.Errorf("permission %s signers weight %d is lower than the threshold %d", p.PermissionName, totalWeight, p.Threshold)
+	}
+
+	if p.Type == PermissionTypeUser {
+		operations, err := ParsePermissionOperations(p.Operations)
+		if err != nil {
+			return fmt.Errorf("permission %s: %w", p.PermissionName, err)
+		}
+
+		if operations.IsEmpty() {
+			return fmt.Errorf("permission %s allows no operations", p.PermissionName)
+		}
+	}
+
+	return nil
+}
+
+// VerifySignature reports whether signature is the signature of hash made by the key of addr
+func VerifySignature(addr string, hash []byte, signature []byte) bool {
+	decoded, err := address.NewAddress(addr)
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return false
+	}
+
+	return ed25519.Verify(decoded.Bytes(), hash, signature)
+}
+
+// FindSigner returns the signer of the permission whose key made the signature of hash
+func (p *Permissions) FindSigner(hash []byte, signature []byte) (*PermissionKey, error) {
+	for i := range p.Signers {
+		if VerifySignature(p.Signers[i].Address, hash, signature) {
+			return &p.Signers[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no signer of permission %d matches", p.ID)
+}
+
+// PermissionCheck is the result of Account.CheckPermission
+type PermissionCheck struct {
+	PermissionID   int32
+	PermissionName string
+	// Weight is the signer weight in the permission, zero if it isn't a signer
+	Weight    int64
+	Threshold int64
+	// Missing are the contract types of the transaction the permission doesn't allow
+	Missing []proto.TXContract_ContractType
+}
+
+// Allowed reports whether the signer can take part in authorizing the transaction
+func (c *PermissionCheck) Allowed() bool {
+	return c.Weight > 0 && len(c.Missing) == 0
+}
+
+// ThresholdReached reports whether the signature of the signer alone authorizes the transaction
+func (c *PermissionCheck) ThresholdReached() bool {
+	return c.Allowed() && c.Weight >= c.Threshold
+}
+
+// CheckPermission checks locally whether `signer` can authorize `tx` with the permission `permID`,
+// the permission must allow every contract of the transaction. Owner permissions allow any contract
+func (acc *Account) CheckPermission(permID int32, signer string, tx *proto.Transaction) (*PermissionCheck, error) {
+	raw := tx.GetRawData()
+	if raw == nil {
+		return nil, fmt.Errorf("transaction without raw data")
+	}
+
+	if raw.GetPermissionID() != permID {
+		return nil, fmt.Errorf("transaction built with permission %d, expected %d", raw.GetPermissionID(), permID)
+	}
+
+	permission, err := acc.GetPermission(permID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raw.GetSender()) != 0 {
+		sender, err := address.NewAddressFromBytes(raw.GetSender())
+		if err != nil {
+			return nil, err
+		}
+
+		if sender.Bech32() != acc.Address {
+			return nil, fmt.Errorf("transaction sender is %s, got account %s", sender.Bech32(), acc.Address)
+		}
+	}
+
+	check := &PermissionCheck{
+		PermissionID:   permission.ID,
+		PermissionName: permission.PermissionName,
+		Weight:         permission.SignerWeight(signer),
+		Threshold:      permission.Threshold,
+	}
+
+	if permission.Type == PermissionTypeOwner {
+		return check, nil
+	}
+
+	operations, err := permission.GetOperations()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contract := range raw.GetContract() {
+		if !operations.Has(contract.GetType()) && !slices.Contains(check.Missing, contract.GetType()) {
+			check.Missing = append(check.Missing, contract.GetType())
+		}
+	}
+
+	return check, nil
+}
@@ -0,0 +1,215 @@
+package models_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	permissionSigner      = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+	permissionOtherSigner = "klv1velayazgrn6mqaqckt7utk9656h8zu3ex4ln8rx7n8p0vy4fd20qmwh4p5"
+)
+
+func TestPermissionOperations_RoundTrip(t *testing.T) {
+	operations, err := models.NewPermissionOperations(
+		proto.TXContract_TransferContractType,
+		proto.TXContract_FreezeContractType,
+		proto.TXContract_VoteContractType,
+		proto.TXContract_SmartContractType,
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, strings.Repeat("00", 24)+"80"+"0000000000"+"4011", operations.String())
+	assert.True(t, operations.Has(proto.TXContract_SmartContractType))
+	assert.False(t, operations.Has(proto.TXContract_ClaimContractType))
+
+	parsed, err := models.ParsePermissionOperations(operations.String())
+	require.Nil(t, err)
+	assert.Equal(t, []proto.TXContract_ContractType{
+		proto.TXContract_TransferContractType,
+		proto.TXContract_FreezeContractType,
+		proto.TXContract_VoteContractType,
+		proto.TXContract_SmartContractType,
+	}, parsed.ContractTypes())
+
+	parsed.Remove(proto.TXContract_SmartContractType)
+	assert.False(t, parsed.Has(proto.TXContract_SmartContractType))
+
+	short, err := models.ParsePermissionOperations("0x01")
+	require.Nil(t, err)
+	assert.Equal(t, []proto.TXContract_ContractType{proto.TXContract_TransferContractType}, short.ContractTypes())
+
+	_, err = models.ParsePermissionOperations("zz")
+	assert.NotNil(t, err)
+
+	_, err = models.NewPermissionOperations(proto.TXContract_ContractType(256))
+	assert.NotNil(t, err)
+}
+
+func TestPermissionOperations_Account(t *testing.T) {
+	// permissions as returned by GET /address/{address}, the user permission allows
+	// transfers (0), freezes (4) and smart contracts (63)
+	data := `{"address": "` + permissionSigner + `", "nonce": 3, "permissions": [
+		{"id": 0, "type": 0, "permissionName": "owner", "Threshold": 1,
+			"operations": "", "signers": [{"address": "` + permissionSigner + `", "weight": 1}]},
+		{"id": 2, "type": 1, "permissionName": "operator", "Threshold": 1,
+			"operations": "0000000000000000000000000000000000000000000000008000000000000011",
+			"signers": [{"address": "` + permissionOtherSigner + `", "weight": 1}]}
+	]}`
+
+	var acc models.Account
+	require.Nil(t, json.Unmarshal([]byte(data), &acc))
+
+	permission, err := acc.GetPermission(2)
+	require.Nil(t, err)
+
+	operations, err := permission.GetOperations()
+	require.Nil(t, err)
+	assert.Equal(t, []proto.TXContract_ContractType{
+		proto.TXContract_TransferContractType,
+		proto.TXContract_FreezeContractType,
+		proto.TXContract_SmartContractType,
+	}, operations.ContractTypes())
+
+	// the short form of the same integer
+	short, err := models.ParsePermissionOperations("8000000000000011")
+	require.Nil(t, err)
+	assert.Equal(t, operations, short)
+}
+
+func TestPermissionTXRequest_Validate(t *testing.T) {
+	valid := func() models.PermissionTXRequest {
+		p := models.PermissionTXRequest{
+			Type:           models.PermissionTypeUser,
+			PermissionName: "transfers",
+			Threshold:      3,
+			Signers: []models.SignerTXRequest{
+				{Address: permissionSigner, Weight: 2},
+				{Address: permissionOtherSigner, Weight: 1},
+			},
+		}
+		require.Nil(t, p.SetOperations(proto.TXContract_TransferContractType))
+		return p
+	}
+
+	p := valid()
+	assert.Nil(t, p.Validate())
+
+	testCases := []struct {
+		name   string
+		change func(p *models.PermissionTXRequest)
+	}{
+		{name: "Threshold_Unreachable", change: func(p *models.PermissionTXRequest) { p.Threshold = 4 }},
+		{name: "Zero_Threshold", change: func(p *models.PermissionTXRequest) { p.Threshold = 0 }},
+		{name: "Invalid_Type", change: func(p *models.PermissionTXRequest) { p.Type = 5 }},
+		{name: "No_Signers", change: func(p *models.PermissionTXRequest) { p.Signers = nil }},
+		{name: "Invalid_Signer", change: func(p *models.PermissionTXRequest) { p.Signers[0].Address = "klv1invalid" }},
+		{name: "Repeated_Signer", change: func(p *models.PermissionTXRequest) { p.Signers[1].Address = permissionSigner }},
+		{name: "Negative_Weight", change: func(p *models.PermissionTXRequest) { p.Signers[1].Weight = -1 }},
+		{name: "No_Operations", change: func(p *models.PermissionTXRequest) { p.Operations = "" }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := valid()
+			tc.change(&p)
+			assert.NotNil(t, p.Validate())
+		})
+	}
+
+	owner := valid()
+	owner.Type = models.PermissionTypeOwner
+	owner.Operations = ""
+	assert.Nil(t, owner.Validate())
+}
+
+func TestAccount_CheckPermission(t *testing.T) {
+	operations, err := models.NewPermissionOperations(proto.TXContract_TransferContractType)
+	require.Nil(t, err)
+
+	acc := &models.Account{AccountInfo: &models.AccountInfo{
+		Address: permissionSigner,
+		Permissions: []models.Permissions{
+			{ID: 0, Type: models.PermissionTypeOwner, Threshold: 1, Signers: []models.PermissionKey{{Address: permissionSigner, Weight: 1}}},
+			{ID: 2, Type: models.PermissionTypeUser, Threshold: 2, Operations: operations.String(), Signers: []models.PermissionKey{
+				{Address: permissionSigner, Weight: 1},
+				{Address: permissionOtherSigner, Weight: 2},
+			}},
+		},
+	}}
+
+	newTX := func(permID int32, contractTypes ...proto.TXContract_ContractType) *proto.Transaction {
+		contracts := make([]*proto.TXContract, 0, len(contractTypes))
+		for _, c := range contractTypes {
+			contracts = append(contracts, &proto.TXContract{Type: c})
+		}
+		return &proto.Transaction{RawData: &proto.Transaction_Raw{PermissionID: permID, Contract: contracts}}
+	}
+
+	check, err := acc.CheckPermission(2, permissionOtherSigner, newTX(2, proto.TXContract_TransferContractType))
+	require.Nil(t, err)
+	assert.True(t, check.Allowed())
+	assert.True(t, check.ThresholdReached())
+
+	check, err = acc.CheckPermission(2, permissionSigner, newTX(2, proto.TXContract_TransferContractType))
+	require.Nil(t, err)
+	assert.True(t, check.Allowed())
+	assert.False(t, check.ThresholdReached())
+
+	check, err = acc.CheckPermission(2, permissionOtherSigner, newTX(2,
+		proto.TXContract_TransferContractType,
+		proto.TXContract_FreezeContractType,
+		proto.TXContract_FreezeContractType,
+	))
+	require.Nil(t, err)
+	assert.False(t, check.Allowed())
+	assert.Equal(t, []proto.TXContract_ContractType{proto.TXContract_FreezeContractType}, check.Missing)
+
+	check, err = acc.CheckPermission(0, permissionSigner, newTX(0, proto.TXContract_FreezeContractType))
+	require.Nil(t, err)
+	assert.True(t, check.ThresholdReached())
+
+	check, err = acc.CheckPermission(0, permissionOtherSigner, newTX(0, proto.TXContract_FreezeContractType))
+	require.Nil(t, err)
+	assert.False(t, check.Allowed())
+
+	_, err = acc.CheckPermission(2, permissionSigner, newTX(0, proto.TXContract_TransferContractType))
+	assert.NotNil(t, err)
+
+	_, err = acc.CheckPermission(7, permissionSigner, newTX(7, proto.TXContract_TransferContractType))
+	assert.NotNil(t, err)
+
+	_, err = acc.CheckPermission(0, permissionSigner, &proto.Transaction{})
+	assert.NotNil(t, err)
+}
+
+func TestAccount_CheckPermission_WithoutPermissions(t *testing.T) {
+	acc := &models.Account{AccountInfo: &models.AccountInfo{Address: permissionSigner}}
+	tx := &proto.Transaction{RawData: &proto.Transaction_Raw{
+		Contract: []*proto.TXContract{{Type: proto.TXContract_FreezeContractType}},
+	}}
+
+	permission, err := acc.GetPermission(0)
+	require.Nil(t, err)
+	assert.Equal(t, models.PermissionTypeOwner, permission.Type)
+
+	check, err := acc.CheckPermission(0, permissionSigner, tx)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), check.Weight)
+	assert.Equal(t, int64(1), check.Threshold)
+	assert.True(t, check.ThresholdReached())
+
+	check, err = acc.CheckPermission(0, permissionOtherSigner, tx)
+	require.Nil(t, err)
+	assert.False(t, check.Allowed())
+
+	tx.RawData.PermissionID = 2
+	_, err = acc.CheckPermission(2, permissionSigner, tx)
+	assert.NotNil(t, err)
+}
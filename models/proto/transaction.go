@@ -14,12 +14,23 @@ type Signer interface {
 	Sign([]byte) ([]byte, error)
 }
 
+// TransactionSigner is a Signer that needs the whole transaction to sign it, e.g. a signing
+// service checking what it signs. Transaction.Sign uses it instead of signing the hash
+type TransactionSigner interface {
+	Signer
+	SignTransaction(ctx context.Context, tx *Transaction) error
+}
+
 type Broadcaster interface {
 	BroadcastTransaction(*Transaction) (string, error)
 	BroadcastTransactionWithContext(context.Context, *Transaction) (string, error)
 }
 
 func (x *Transaction) Sign(signer Signer) error {
+	if txSigner, ok := signer.(TransactionSigner); ok {
+		return txSigner.SignTransaction(context.Background(), x)
+	}
+
 	signature, err := signer.Sign(x.Hash)
 	if err != nil {
 		return err
@@ -8,6 +8,10 @@ type BaseTX struct {
 	PermID      int32
 	Message     []string
 	KdaFee      string
+	// GasLimit and GasMultiplier bound the gas of smart contract calls,
+	// zero values are filled by the node
+	GasLimit      uint64
+	GasMultiplier uint64
 }
 
 // SendTXRequest -
@@ -20,6 +24,9 @@ type SendTXRequest struct {
 	Contract  interface{}   `form:"contract" json:"contract"`
 	Contracts []interface{} `form:"contracts" json:"contracts"`
 	KDAFee    string        `form:"kdaFee" json:"kdaFee"`
+
+	GasLimit      uint64 `form:"gasLimit" json:"gasLimit,omitempty"`
+	GasMultiplier uint64 `form:"gasMultiplier" json:"gasMultiplier,omitempty"`
 }
 
 // TransferTXRequest -
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+// SCLogs are the logs emitted during a smart contract execution
+type SCLogs struct {
+	Address string     `json:"address"`
+	Events  []*SCEvent `json:"events"`
+}
+
+// SCEvent is a smart contract log entry, topics and data items are base64 encoded.
+// The first topic is the event identifier, followed by the indexed event inputs
+type SCEvent struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       []string `json:"data"`
+}
+
+// UnmarshalJSON accepts data as a single item or a list of items
+func (e *SCEvent) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Address    string          `json:"address"`
+		Identifier string          `json:"identifier"`
+		Topics     []string        `json:"topics"`
+		Data       json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	e.Address = raw.Address
+	e.Identifier = raw.Identifier
+	e.Topics = raw.Topics
+	e.Data = nil
+
+	if len(raw.Data) == 0 || string(raw.Data) == "null" {
+		return nil
+	}
+
+	var single string
+	if err := json.Unmarshal(raw.Data, &single); err == nil {
+		if len(single) != 0 {
+			e.Data = []string{single}
+		}
+		return nil
+	}
+
+	return json.Unmarshal(raw.Data, &e.Data)
+}
@@ -30,6 +30,14 @@ type SignableMessage interface {
 	LoadJSON(string) error
 }
 
+// MessageSigner is a Signer that needs the message itself to sign it, e.g. a signing
+// service hashing the message on its side. SignableMessage.Sign uses it instead of
+// signing the message hash
+type MessageSigner interface {
+	proto.Signer
+	SignMessage(message []byte) ([]byte, error)
+}
+
 type signableMessage struct {
 	Address   address.Address
 	Message   []byte
@@ -100,8 +108,13 @@ func (sm *signableMessage) Verify() bool {
 }
 
 func (sm *signableMessage) Sign(wallet proto.Signer) error {
-	data := sm.serializeForSigning()
-	siganture, err := wallet.Sign(data)
+	var siganture []byte
+	var err error
+	if messageSigner, ok := wallet.(MessageSigner); ok {
+		siganture, err = messageSigner.SignMessage(sm.Message)
+	} else {
+		siganture, err = wallet.Sign(sm.serializeForSigning())
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+// SimulationResult is the predicted outcome of a transaction executed by the node
+// without being committed, ReturnData items are base64 encoded
+type SimulationResult struct {
+	Status        string                   `json:"status"`
+	ResultCode    string                   `json:"resultCode"`
+	KAppFee       int64                    `json:"kAppFee"`
+	BandwidthFee  int64                    `json:"bandwidthFee"`
+	GasUsed       uint64                   `json:"gasUsed"`
+	Receipts      []map[string]interface{} `json:"receipts"`
+	Logs          *SCLogs                  `json:"logs,omitempty"`
+	ReturnData    []string                 `json:"returnData"`
+	ReturnMessage string                   `json:"returnMessage"`
+}
+
+// Result returns the predicted transaction result
+func (r *SimulationResult) Result() proto.Transaction_TXResult {
+	if strings.EqualFold(r.Status, "success") || strings.EqualFold(r.Status, proto.Transaction_SUCCESS.String()) {
+		return proto.Transaction_SUCCESS
+	}
+
+	return proto.Transaction_FAILED
+}
+
+// Code returns the predicted result code, unknown codes are reported as Fail
+func (r *SimulationResult) Code() proto.Transaction_TXResultCode {
+	if len(r.ResultCode) == 0 && r.Result() == proto.Transaction_SUCCESS {
+		return proto.Transaction_Ok
+	}
+
+	code, exists := proto.Transaction_TXResultCode_value[r.ResultCode]
+	if !exists {
+		return proto.Transaction_Fail
+	}
+
+	return proto.Transaction_TXResultCode(code)
+}
+
+// HexReturnData returns the return data items hex encoded
+func (r *SimulationResult) HexReturnData() ([]string, error) {
+	return hexReturnData(r.ReturnData)
+}
+
+// Transaction returns the simulation as a TransactionAPI, so it can be read by
+// the helpers made for executed transactions, e.g. the smart contract event decoder
+func (r *SimulationResult) Transaction() *TransactionAPI {
+	return &TransactionAPI{
+		KAppFee:      r.KAppFee,
+		BandwidthFee: r.BandwidthFee,
+		Status:       r.Status,
+		ResultCode:   r.ResultCode,
+		Receipts:     r.Receipts,
+		Logs:         r.Logs,
+	}
+}
@@ -13,6 +13,12 @@ type ToAmount struct {
 	KDA       string
 }
 
+type ToExactAmount struct {
+	ToAddress string
+	Amount    Amount
+	KDA       string
+}
+
 type URI struct {
 	Key   string `json:"key,omitempty"`
 	Value string `json:"value,omitempty"`
@@ -35,6 +41,7 @@ type TransactionAPI struct {
 	Signature    []string                 `json:"signature,omitempty"`
 	SearchOrder  uint32                   `json:"searchOrder"`
 	Receipts     []map[string]interface{} `json:"receipts"`
+	Logs         *SCLogs                  `json:"logs,omitempty"`
 	Contracts    []*TXContractAPI         `json:"contract"`
 }
 
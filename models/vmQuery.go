@@ -0,0 +1,40 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// SCQueryRequest -
+type SCQueryRequest struct {
+	ScAddress string   `form:"scAddress" json:"scAddress"`
+	FuncName  string   `form:"funcName" json:"funcName"`
+	Args      []string `form:"args" json:"args"`
+	Caller    string   `form:"caller" json:"caller,omitempty"`
+}
+
+// SCQueryResult is the output of a readonly smart contract call, ReturnData items are base64 encoded
+type SCQueryResult struct {
+	ReturnData    []string `json:"returnData"`
+	ReturnCode    string   `json:"returnCode"`
+	ReturnMessage string   `json:"returnMessage"`
+	GasRemaining  uint64   `json:"gasRemaining"`
+}
+
+// HexReturnData returns the return data items hex encoded
+func (r *SCQueryResult) HexReturnData() ([]string, error) {
+	return hexReturnData(r.ReturnData)
+}
+
+func hexReturnData(returnData []string) ([]string, error) {
+	hexData := make([]string, 0, len(returnData))
+	for _, data := range returnData {
+		b, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, err
+		}
+		hexData = append(hexData, hex.EncodeToString(b))
+	}
+
+	return hexData, nil
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// WaitOptions configures how WaitForTransaction polls the node, zero values use the defaults
+type WaitOptions struct {
+	// PollInterval is the first delay between polls, doubled after each attempt
+	PollInterval time.Duration
+	// MaxPollInterval caps the delay between polls
+	MaxPollInterval time.Duration
+	// Confirmations is the number of blocks required on top of the transaction block
+	Confirmations uint64
+}
@@ -0,0 +1,541 @@
+// Package abigen generates typed Go bindings for smart contracts from their ABI JSON
+package abigen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+const readonlyMutability = "readonly"
+
+type abiInput struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	MultiArg bool   `json:"multi_arg,omitempty"`
+}
+
+type abiOutput struct {
+	Type string `json:"type"`
+}
+
+type abiEndpoint struct {
+	Name            string      `json:"name"`
+	Mutability      string      `json:"mutability"`
+	PayableInTokens []string    `json:"payableInTokens,omitempty"`
+	Inputs          []abiInput  `json:"inputs"`
+	Outputs         []abiOutput `json:"outputs"`
+}
+
+type abiField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type abiVariant struct {
+	Name         string     `json:"name"`
+	Discriminant uint       `json:"discriminant"`
+	Fields       []abiField `json:"fields,omitempty"`
+}
+
+type abiType struct {
+	Type     string       `json:"type"`
+	Fields   []abiField   `json:"fields,omitempty"`
+	Variants []abiVariant `json:"variants,omitempty"`
+}
+
+type abi struct {
+	Name        string             `json:"name"`
+	Constructor abiEndpoint        `json:"constructor"`
+	Endpoints   []abiEndpoint      `json:"endpoints"`
+	Types       map[string]abiType `json:"types"`
+}
+
+// Options configures Generate
+type Options struct {
+	// Package is the generated package name, defaults to the lower case contract name
+	Package string
+	// TypeName is the contract binding type, defaults to the ABI name
+	TypeName string
+	// Source is the ABI file name written in the generated header
+	Source string
+}
+
+type generator struct {
+	abi     abi
+	raw     []byte
+	options Options
+	buf     bytes.Buffer
+	usesBig bool
+	usesFmt bool
+	usesCtx bool
+}
+
+// Generate reads an ABI JSON and returns the formatted source of a Go package with a type for
+// every ABI struct and enum, a Deploy function for the constructor, a method building a
+// transaction for every mutable endpoint and a method returning typed results for every
+// readonly endpoint
+func Generate(r io.Reader, options Options) ([]byte, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{raw: raw, options: options}
+	if err := json.Unmarshal(raw, &g.abi); err != nil {
+		return nil, fmt.Errorf("invalid abi: %w", err)
+	}
+
+	if len(g.options.TypeName) == 0 {
+		g.options.TypeName = exportedName(g.abi.Name)
+	}
+	if len(g.options.TypeName) == 0 {
+		return nil, fmt.Errorf("abi has no name, a type name must be provided")
+	}
+	if len(g.options.Package) == 0 {
+		g.options.Package = strings.ToLower(g.options.TypeName)
+	}
+
+	body, err := g.generateBody()
+	if err != nil {
+		return nil, err
+	}
+
+	g.writeHeader()
+	g.buf.Write(body)
+
+	source, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated code: %w", err)
+	}
+
+	return source, nil
+}
+
+func (g *generator) writeHeader() {
+	source := g.options.Source
+	if len(source) == 0 {
+		source = "abi"
+	}
+
+	fmt.Fprintf(&g.buf, "// Code generated by abigen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&g.buf, "package %s\n\n", g.options.Package)
+	g.buf.WriteString("import (\n")
+	if g.usesCtx {
+		g.buf.WriteString("\t\"context\"\n")
+	}
+	if g.usesFmt {
+		g.buf.WriteString("\t\"fmt\"\n")
+	}
+	if g.usesBig {
+		g.buf.WriteString("\t\"math/big\"\n")
+	}
+	g.buf.WriteString("\t\"strings\"\n\n")
+	g.buf.WriteString("\t\"github.com/klever-io/klever-go-sdk/models\"\n")
+	g.buf.WriteString("\t\"github.com/klever-io/klever-go-sdk/models/proto\"\n")
+	g.buf.WriteString("\t\"github.com/klever-io/klever-go-sdk/provider\"\n)\n\n")
+}
+
+func (g *generator) generateBody() ([]byte, error) {
+	var body bytes.Buffer
+	w := func(format string, args ...interface{}) {
+		fmt.Fprintf(&body, format, args...)
+	}
+
+	name := g.options.TypeName
+
+	w("// abiJSON is the ABI the bindings were generated from\n")
+	w("const abiJSON = %s\n\n", quote(string(g.raw)))
+
+	if err := g.generateTypes(&body); err != nil {
+		return nil, err
+	}
+
+	w("// %s is a typed binding for the %s smart contract\n", name, g.abi.Name)
+	w("type %s struct {\n", name)
+	w("\taddress string\n\tkc provider.KleverChain\n")
+	w("\tencoder provider.VMInputEncoder\n\tdecoder provider.VMOutputData\n}\n\n")
+
+	w("// New%s creates a binding for the contract deployed at scAddress\n", name)
+	w("func New%s(kc provider.KleverChain, scAddress string) (*%s, error) {\n", name, name)
+	w("\tencoder, decoder, err := newCodecs()\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	w("\treturn &%s{address: scAddress, kc: kc, encoder: encoder, decoder: decoder}, nil\n}\n\n", name)
+
+	w("func newCodecs() (provider.VMInputEncoder, provider.VMOutputData, error) {\n")
+	w("\tencoder := provider.NewVMInputEncoder()\n")
+	w("\tif err := encoder.LoadAbi(strings.NewReader(abiJSON)); err != nil {\n\t\treturn nil, nil, err\n\t}\n\n")
+	w("\tdecoder := provider.NewVMOutputHandler()\n")
+	w("\tif err := decoder.LoadAbi(strings.NewReader(abiJSON)); err != nil {\n\t\treturn nil, nil, err\n\t}\n\n")
+	w("\treturn encoder, decoder, nil\n}\n\n")
+
+	w("// Address returns the smart contract address\n")
+	w("func (c *%s) Address() string {\n\treturn c.address\n}\n\n", name)
+
+	params, args, err := g.inputs(g.abi.Constructor.Inputs, map[string]bool{
+		"kc": true, "base": true, "wasmPath": true, "payable": true,
+		"payableBySC": true, "upgradeable": true, "readable": true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("constructor: %w", err)
+	}
+
+	w("// Deploy builds the deploy transaction of the contract with wasm read from wasmPath\n")
+	w("func Deploy(kc provider.KleverChain, base *models.BaseTX, wasmPath string, payable, payableBySC, upgradeable, readable bool%s) (*proto.Transaction, error) {\n", params)
+	w("\tencoder, _, err := newCodecs()\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	w("\targs, err := encoder.EncodeConstructor(%s)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\n", args)
+	w("\treturn kc.DeploySmartContract(base, wasmPath, payable, payableBySC, upgradeable, readable, \"\", args.Arguments()...)\n}\n\n")
+
+	seen := make(map[string]bool)
+	for _, e := range g.abi.Endpoints {
+		method := exportedName(e.Name)
+		if seen[method] || method == "Address" {
+			return nil, fmt.Errorf("endpoint %s conflicts with a generated method", e.Name)
+		}
+		seen[method] = true
+
+		var err error
+		if e.Mutability == readonlyMutability {
+			err = g.generateView(&body, method, e)
+		} else {
+			err = g.generateCall(&body, method, e)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %s: %w", e.Name, err)
+		}
+	}
+
+	return body.Bytes(), nil
+}
+
+func (g *generator) generateTypes(body *bytes.Buffer) error {
+	names := make([]string, 0, len(g.abi.Types))
+	for typeName := range g.abi.Types {
+		names = append(names, typeName)
+	}
+	sort.Strings(names)
+
+	for _, typeName := range names {
+		t := g.abi.Types[typeName]
+		goName := exportedName(typeName)
+
+		if t.Type == "enum" {
+			if !isSimpleEnum(t) {
+				fmt.Fprintf(body, "// %s is an enum with fields, set it as map[string][]interface{}{\"Variant\": {fields...}}\n", goName)
+				fmt.Fprintf(body, "type %s = interface{}\n\n", goName)
+				continue
+			}
+
+			fmt.Fprintf(body, "type %s string\n\nconst (\n", goName)
+			for _, v := range t.Variants {
+				fmt.Fprintf(body, "\t%s%s %s = %q\n", goName, exportedName(v.Name), goName, v.Name)
+			}
+			body.WriteString(")\n\n")
+			continue
+		}
+
+		fmt.Fprintf(body, "type %s struct {\n", goName)
+		for _, f := range t.Fields {
+			goType, err := g.goType(f.Type)
+			if err != nil {
+				return fmt.Errorf("type %s: %w", typeName, err)
+			}
+			fmt.Fprintf(body, "\t%s %s `abi:%q`\n", exportedName(f.Name), goType, f.Name)
+		}
+		body.WriteString("}\n\n")
+	}
+
+	return nil
+}
+
+func (g *generator) generateCall(body *bytes.Buffer, method string, e abiEndpoint) error {
+	reserved := map[string]bool{"base": true, "callValue": true, "c": true}
+
+	params, args, err := g.inputs(e.Inputs, reserved)
+	if err != nil {
+		return err
+	}
+
+	callValue := "map[string]int64{}"
+	if len(e.PayableInTokens) != 0 {
+		params = ", callValue map[string]int64" + params
+		callValue = "callValue"
+	}
+
+	fmt.Fprintf(body, "// %s builds a transaction calling the %s endpoint\n", method, e.Name)
+	fmt.Fprintf(body, "func (c *%s) %s(base *models.BaseTX%s) (*proto.Transaction, error) {\n", g.options.TypeName, method, params)
+	fmt.Fprintf(body, "\targs, err := c.encoder.EncodeEndpoint(%q%s)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\n", e.Name, prefixComma(args))
+	fmt.Fprintf(body, "\treturn c.kc.InvokeSmartContract(base, c.address, %q, %s, args.Arguments()...)\n}\n\n", e.Name, callValue)
+
+	return nil
+}
+
+func (g *generator) generateView(body *bytes.Buffer, method string, e abiEndpoint) error {
+	reserved := map[string]bool{"ctx": true, "c": true}
+
+	params, args, err := g.inputs(e.Inputs, reserved)
+	if err != nil {
+		return err
+	}
+
+	results := make([]string, 0, len(e.Outputs))
+	for _, o := range e.Outputs {
+		goType, err := g.goType(o.Type)
+		if err != nil {
+			return err
+		}
+		results = append(results, goType)
+	}
+
+	returns := "error"
+	if len(results) != 0 {
+		returns = "(" + strings.Join(results, ", ") + ", error)"
+	}
+
+	names := make([]string, 0, len(results))
+	for i := range results {
+		names = append(names, fmt.Sprintf("r%d", i))
+	}
+	failed := strings.Join(append(append([]string{}, names...), "err"), ", ")
+
+	g.usesCtx = true
+
+	fmt.Fprintf(body, "// %s queries the readonly %s endpoint\n", method, e.Name)
+	fmt.Fprintf(body, "func (c *%s) %s(ctx context.Context%s) %s {\n", g.options.TypeName, method, params, returns)
+	for i, result := range results {
+		fmt.Fprintf(body, "\tvar %s %s\n", names[i], result)
+	}
+	if len(results) != 0 {
+		body.WriteString("\n")
+	}
+	fmt.Fprintf(body, "\targs, err := c.encoder.EncodeEndpoint(%q%s)\n\tif err != nil {\n\t\treturn %s\n\t}\n\n", e.Name, prefixComma(args), failed)
+
+	if len(results) == 0 {
+		fmt.Fprintf(body, "\t_, err = c.kc.QuerySmartContract(ctx, c.address, %q, args.Arguments()...)\n\n\treturn err\n}\n\n", e.Name)
+		return nil
+	}
+
+	fmt.Fprintf(body, "\tresult, err := c.kc.QuerySmartContract(ctx, c.address, %q, args.Arguments()...)\n\tif err != nil {\n\t\treturn %s\n\t}\n\n", e.Name, failed)
+	fmt.Fprintf(body, "\tdecoded, err := c.decoder.DecodeQuery(%q, result.ReturnData)\n\tif err != nil {\n\t\treturn %s\n\t}\n\n", e.Name, failed)
+
+	if len(results) == 1 {
+		fmt.Fprintf(body, "\terr = provider.DecodeInto(decoded, &r0)\n\n\treturn r0, err\n}\n\n")
+		return nil
+	}
+
+	g.usesFmt = true
+
+	fmt.Fprintf(body, "\tvalues, ok := decoded.([]interface{})\n\tif !ok || len(values) != %d {\n", len(results))
+	fmt.Fprintf(body, "\t\treturn %s\n\t}\n\n", strings.Replace(failed, "err", fmt.Sprintf("fmt.Errorf(\"unexpected results for %s: %%v\", decoded)", e.Name), 1))
+	for i := range results {
+		fmt.Fprintf(body, "\tif err := provider.DecodeInto(values[%d], &r%d); err != nil {\n\t\treturn %s\n\t}\n", i, i, failed)
+	}
+	fmt.Fprintf(body, "\n\treturn %s\n}\n\n", strings.Replace(failed, "err", "nil", 1))
+
+	return nil
+}
+
+// inputs returns the parameter list and the argument list of the endpoint inputs
+func (g *generator) inputs(inputs []abiInput, reserved map[string]bool) (string, string, error) {
+	var params, args []string
+	used := make(map[string]bool)
+
+	for i, in := range inputs {
+		goType, err := g.goType(in.Type)
+		if err != nil {
+			return "", "", err
+		}
+
+		name := paramName(in.Name)
+		if len(name) == 0 {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		for reserved[name] || used[name] {
+			name += "Arg"
+		}
+		used[name] = true
+
+		params = append(params, fmt.Sprintf("%s %s", name, goType))
+		args = append(args, name)
+	}
+
+	if len(params) == 0 {
+		return "", "", nil
+	}
+
+	return ", " + strings.Join(params, ", "), strings.Join(args, ", "), nil
+}
+
+func (g *generator) goType(t string) (string, error) {
+	if t == utils.VecU8 || t == utils.SliceU8 {
+		return "string", nil
+	}
+
+	wrapper, valueType := utils.SplitTypes(t)
+
+	switch wrapper {
+	case "":
+	case utils.Option, "optional":
+		inner, err := g.goType(valueType)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(inner, "*") || inner == "interface{}" || inner == "[]interface{}" {
+			return inner, nil
+		}
+		return "*" + inner, nil
+	case utils.List, utils.Variadic:
+		inner, err := g.goType(valueType)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + inner, nil
+	case utils.Tuple, "multi":
+		for _, item := range utils.SplitTupleTypes(valueType) {
+			if _, err := g.goType(item); err != nil {
+				return "", err
+			}
+		}
+		return "[]interface{}", nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", t)
+	}
+
+	switch valueType {
+	case utils.Int8:
+		return "int8", nil
+	case utils.Int16:
+		return "int16", nil
+	case utils.Int32, utils.Isize:
+		return "int32", nil
+	case utils.Int64:
+		return "int64", nil
+	case utils.Uint8:
+		return "uint8", nil
+	case utils.Uint16:
+		return "uint16", nil
+	case utils.Uint32, utils.Usize:
+		return "uint32", nil
+	case utils.Uint64:
+		return "uint64", nil
+	case utils.BigInt, utils.BigUint:
+		g.usesBig = true
+		return "*big.Int", nil
+	case utils.BigFloat:
+		g.usesBig = true
+		return "*big.Float", nil
+	case utils.Boolean:
+		return "bool", nil
+	case
+		utils.Address,
+		utils.ManagedBuffer,
+		utils.TokenIdentifier,
+		utils.Bytes,
+		utils.BoxedBytes,
+		utils.String,
+		utils.StrRef:
+		return "string", nil
+	}
+
+	typeDef, exists := g.abi.Types[valueType]
+	if !exists {
+		return "", fmt.Errorf("type %s not found in abi", valueType)
+	}
+	if typeDef.Type == "enum" && !isSimpleEnum(typeDef) {
+		return "interface{}", nil
+	}
+
+	return exportedName(valueType), nil
+}
+
+func isSimpleEnum(t abiType) bool {
+	for _, v := range t.Variants {
+		if len(v.Fields) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// exportedName converts snake case and camel case names to an exported Go identifier
+func exportedName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	exported := b.String()
+	if len(exported) != 0 && unicode.IsDigit([]rune(exported)[0]) {
+		exported = "V" + exported
+	}
+
+	return exported
+}
+
+func paramName(name string) string {
+	exported := exportedName(name)
+	if len(exported) == 0 {
+		return ""
+	}
+
+	runes := []rune(exported)
+	runes[0] = unicode.ToLower(runes[0])
+	param := string(runes)
+
+	if isKeyword(param) || isResultName(param) {
+		return param + "Arg"
+	}
+
+	return param
+}
+
+func isKeyword(name string) bool {
+	switch name {
+	case "break", "case", "chan", "const", "continue", "default", "defer", "else",
+		"fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map",
+		"package", "range", "return", "select", "struct", "switch", "type", "var",
+		"args", "result", "decoded", "err", "encoder", "kc", "proto", "models", "provider",
+		"fmt", "context", "strings", "big", "values", "ok":
+		return true
+	}
+
+	return false
+}
+
+// isResultName reports names used for the results of generated views, e.g. r0
+func isResultName(name string) bool {
+	if len(name) < 2 || name[0] != 'r' {
+		return false
+	}
+
+	_, err := strconv.Atoi(name[1:])
+	return err == nil
+}
+
+func prefixComma(args string) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	return ", " + args
+}
+
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+
+	return "`" + s + "`"
+}
@@ -0,0 +1,149 @@
+package abigen_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/cmd/demo/smartContracts/bindings/lottery"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/abigen"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+const (
+	testScAddress = "klv1qqqqqqqqqqqqqpgq4f6qkvv34kr50w5lucdn6z0238r7m96nhtxspjw22t"
+	testAddress   = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+	testHex       = "e41b323a571fd955e09cd41660ff4465c3f44693c87f2faea4a0fc408727c8ea"
+)
+
+func TestGenerate_LotteryBindingsUpToDate(t *testing.T) {
+	file, err := os.Open("../../cmd/demo/smartContracts/scFiles/lottery-kda.abi.json")
+	require.Nil(t, err)
+	defer file.Close()
+
+	source, err := abigen.Generate(file, abigen.Options{Package: "lottery", Source: "lottery-kda.abi.json"})
+	require.Nil(t, err)
+
+	committed, err := os.ReadFile("../../cmd/demo/smartContracts/bindings/lottery/lottery.go")
+	require.Nil(t, err)
+
+	assert.Equal(t, string(committed), string(source), "run go generate in cmd/demo/smartContracts/bindings/lottery")
+}
+
+func TestGenerate_Signatures(t *testing.T) {
+	abi := `{
+		"name": "multi_outputs",
+		"constructor": {"inputs": [{"name": "type", "type": "u8"}], "outputs": []},
+		"endpoints": [
+			{"name": "pair", "mutability": "readonly", "inputs": [{"name": "r0", "type": "Option<u64>"}], "outputs": [{"type": "u32"}, {"type": "List<BigFloat>"}]},
+			{"name": "ping", "mutability": "readonly", "inputs": [], "outputs": []},
+			{"name": "act", "mutability": "mutable", "inputs": [{"name": "action", "type": "Action"}, {"name": "values", "type": "variadic<tuple<u8,bytes>>", "multi_arg": true}], "outputs": []}
+		],
+		"types": {
+			"Action": {"type": "enum", "variants": [{"name": "Send", "discriminant": 0, "fields": [{"name": "0", "type": "Address"}]}]}
+		}
+	}`
+
+	source, err := abigen.Generate(strings.NewReader(abi), abigen.Options{})
+	require.Nil(t, err)
+
+	code := string(source)
+	assert.Contains(t, code, "package multioutputs")
+	assert.Contains(t, code, "type MultiOutputs struct")
+	assert.Contains(t, code, "readable bool, typeArg uint8)")
+	assert.Contains(t, code, "func (c *MultiOutputs) Pair(ctx context.Context, r0Arg *uint64) (uint32, []*big.Float, error)")
+	assert.Contains(t, code, "func (c *MultiOutputs) Ping(ctx context.Context) error")
+	assert.Contains(t, code, "func (c *MultiOutputs) Act(base *models.BaseTX, action interface{}, valuesArg [][]interface{}) (*proto.Transaction, error)")
+
+	_, err = abigen.Generate(strings.NewReader(`{"name": "x", "endpoints": [{"name": "a", "inputs": [{"name": "v", "type": "Unknown"}]}]}`), abigen.Options{})
+	assert.NotNil(t, err)
+
+	_, err = abigen.Generate(strings.NewReader(`{"endpoints": []}`), abigen.Options{})
+	assert.NotNil(t, err)
+}
+
+func TestGenerate_LotteryViews(t *testing.T) {
+	responses := map[string][]string{
+		"status": {"01"},
+		"getLotteryInfo": {
+			"00000003" + "4b4c56" +
+				"00000002" + "03e8" +
+				"00000064" +
+				"0000000065000000" +
+				"00000002" +
+				"00000001" + "64" +
+				"00000001" + "05",
+		},
+		"getLotteryWhitelist": {testHex, testHex},
+	}
+
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/vm/query", r.URL.Path)
+
+		var request models.SCQueryRequest
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, testScAddress, request.ScAddress)
+		assert.Equal(t, []string{"64656d6f"}, request.Args)
+		calls = append(calls, request.FuncName)
+
+		returnData := make([]string, 0)
+		for _, h := range responses[request.FuncName] {
+			b, _ := hex.DecodeString(h)
+			returnData = append(returnData, base64.StdEncoding.EncodeToString(b))
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"data": models.SCQueryResult{ReturnData: returnData, ReturnCode: "Ok"},
+			},
+			"code": "successful",
+		})
+	}))
+	defer server.Close()
+
+	kc, err := provider.NewKleverChain(
+		network.NewNetworkConfigCustom(server.URL, server.URL, server.URL),
+		utils.NewHttpClient(time.Second),
+	)
+	require.Nil(t, err)
+
+	contract, err := lottery.NewLottery(kc, testScAddress)
+	require.Nil(t, err)
+
+	status, err := contract.Status(context.Background(), "demo")
+	require.Nil(t, err)
+	assert.Equal(t, lottery.StatusRunning, status)
+
+	info, err := contract.GetLotteryInfo(context.Background(), "demo")
+	require.Nil(t, err)
+	assert.Equal(t, lottery.LotteryInfo{
+		TokenIdentifier:   "KLV",
+		TicketPrice:       big.NewInt(1000),
+		TicketsLeft:       100,
+		Deadline:          1694498816,
+		MaxEntriesPerUser: 2,
+		PrizeDistribution: "d",
+		PrizePool:         big.NewInt(5),
+	}, info)
+
+	whitelist, err := contract.GetLotteryWhitelist(context.Background(), "demo")
+	require.Nil(t, err)
+	assert.Equal(t, []string{testAddress, testAddress}, whitelist)
+
+	assert.Equal(t, []string{"status", "getLotteryInfo", "getLotteryWhitelist"}, calls)
+}
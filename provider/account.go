@@ -14,7 +14,7 @@ func (kc *kleverChain) GetAccountWithContext(ctx context.Context, address string
 		} `json:"data"`
 	}{}
 
-	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/address/%s", kc.networkConfig.GetAPIUri(), address), &result)
+	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/address/%s", kc.apiUri(ctx), address), &result)
 
 	return result.Data.Account, err
 }
@@ -30,7 +30,7 @@ func (kc *kleverChain) GetAccountAllowanceWithContext(ctx context.Context, addre
 		} `json:"data"`
 	}{}
 
-	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/address/%s/allowance?assetID=%s", kc.networkConfig.GetAPIUri(), address, kda), &result)
+	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/address/%s/allowance?assetID=%s", kc.apiUri(ctx), address, kda), &result)
 
 	return result.Data.Result, err
 }
@@ -17,7 +17,7 @@ func (kc *kleverChain) GetAssetWithContext(ctx context.Context, assetID string)
 		} `json:"data"`
 	}{}
 
-	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/asset/%s", kc.networkConfig.GetNodeUri(), assetID), &result)
+	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/asset/%s", kc.nodeUri(ctx), assetID), &result)
 
 	return result.Data.Asset, err
 }
@@ -61,17 +61,65 @@ func (kc *kleverChain) AssetTrigger(
 		return nil, fmt.Errorf("invalid KDA ID")
 	}
 
-	asset, err := kc.GetAsset(kda[0])
+	precision, err := kc.fungiblePrecision(kda[0])
 	if err != nil {
 		return nil, err
 	}
 
-	parsedAmount := op.Amount
+	parsedAmount := op.Amount * math.Pow10(int(precision))
+
+	return kc.assetTrigger(base, kdaID, triggerType, int64(parsedAmount), op)
+}
+
+// AssetTriggerExact is the exact precision version of AssetTrigger, `op.Amount` is ignored
+func (kc *kleverChain) AssetTriggerExact(
+	base *models.BaseTX,
+	kdaID string,
+	triggerType AssetTriggerType,
+	amount models.Amount,
+	op *models.AssetTriggerOptions,
+) (*proto.Transaction, error) {
+	// check if is NFT
+	kda := strings.Split(kdaID, "/")
+	if len(kda) > 2 {
+		return nil, fmt.Errorf("invalid KDA ID")
+	}
+
+	precision, err := kc.fungiblePrecision(kda[0])
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAmount, err := amount.ToUnits(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.assetTrigger(base, kdaID, triggerType, parsedAmount, op)
+}
+
+// fungiblePrecision returns the precision used to scale amounts of an asset,
+// amounts of non fungible assets are not scaled
+func (kc *kleverChain) fungiblePrecision(assetID string) (uint32, error) {
+	asset, err := kc.GetAsset(assetID)
+	if err != nil {
+		return 0, err
+	}
 
 	if asset.AssetType == proto.KDAData_Fungible {
-		parsedAmount = parsedAmount * math.Pow10(int(asset.Precision))
+		return asset.Precision, nil
 	}
 
+	return 0, nil
+}
+
+func (kc *kleverChain) assetTrigger(
+	base *models.BaseTX,
+	kdaID string,
+	triggerType AssetTriggerType,
+	amount int64,
+	op *models.AssetTriggerOptions,
+) (*proto.Transaction, error) {
 	if len(op.AddRolesMint) == 1 &&
 		len(op.AddRolesSetITOPrices) == 1 &&
 		op.AddRolesMint[0] != op.AddRolesSetITOPrices[0] {
@@ -101,7 +149,7 @@ func (kc *kleverChain) AssetTrigger(
 	contracts = append(contracts, models.AssetTriggerTXRequest{
 		TriggerType: uint32(triggerType),
 		AssetID:     kdaID,
-		Amount:      int64(parsedAmount),
+		Amount:      amount,
 		Receiver:    op.Receiver,
 		MIME:        op.Mime,
 		Logo:        op.Logo,
@@ -186,22 +234,48 @@ func (kc *kleverChain) Deposit(
 		return nil, fmt.Errorf("invalid KDA ID")
 	}
 
-	currencyAsset, err := kc.GetAsset(currency[0])
+	precision, err := kc.fungiblePrecision(currency[0])
 	if err != nil {
 		return nil, err
 	}
 
-	parsedAmount := op.Amount
+	parsedAmount := op.Amount * math.Pow10(int(precision))
 
-	if currencyAsset.AssetType == proto.KDAData_Fungible {
-		parsedAmount = parsedAmount * math.Pow10(int(currencyAsset.Precision))
+	return kc.deposit(base, op, int64(parsedAmount))
+}
+
+// DepositExact is the exact precision version of Deposit, `op.Amount` is ignored
+func (kc *kleverChain) DepositExact(
+	base *models.BaseTX,
+	op *models.DepositOptions,
+	amount models.Amount,
+) (*proto.Transaction, error) {
+
+	// check if it's not a NFT
+	currency := strings.Split(op.CurrencyID, "/")
+	if len(currency) > 1 {
+		return nil, fmt.Errorf("invalid KDA ID")
 	}
 
+	precision, err := kc.fungiblePrecision(currency[0])
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAmount, err := amount.ToUnits(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.deposit(base, op, parsedAmount)
+}
+
+func (kc *kleverChain) deposit(base *models.BaseTX, op *models.DepositOptions, amount int64) (*proto.Transaction, error) {
 	contracts := []interface{}{models.DepositTXRequest{
 		DepositType: int32(op.DepositType),
 		KDA:         op.KDAID,
 		CurrencyID:  op.CurrencyID,
-		Amount:      int64(parsedAmount),
+		Amount:      amount,
 	}}
 
 	data, err := kc.buildRequest(proto.TXContract_DepositContractType, base, contracts)
@@ -213,37 +287,64 @@ func (kc *kleverChain) Deposit(
 }
 
 func (kc *kleverChain) Withdraw(base *models.BaseTX, op *models.WithdrawOptions) (*proto.Transaction, error) {
+	var parsedAmount int64
 
-	var withdrawTX models.WithdrawTXRequest
-
-	switch op.WithdrawType {
-	case models.StakingWithdraw:
-		withdrawTX = models.WithdrawTXRequest{
-			WithdrawType: int32(op.WithdrawType),
-			KDA:          op.KDA,
+	if op.WithdrawType == models.KDAPoolWithdraw {
+		precision, err := kc.withdrawPrecision(op)
+		if err != nil {
+			return nil, err
 		}
 
-	case models.KDAPoolWithdraw:
-		currency := strings.Split(op.CurrencyID, "/")
-		if len(currency) > 1 {
-			return nil, fmt.Errorf("invalid KDA ID")
+		parsedAmount = int64(op.Amount * math.Pow10(int(precision)))
+	}
+
+	return kc.withdraw(base, op, parsedAmount)
+}
+
+// WithdrawExact is the exact precision version of Withdraw, `op.Amount` is ignored
+func (kc *kleverChain) WithdrawExact(base *models.BaseTX, op *models.WithdrawOptions, amount models.Amount) (*proto.Transaction, error) {
+	var parsedAmount int64
+
+	if op.WithdrawType == models.KDAPoolWithdraw {
+		precision, err := kc.withdrawPrecision(op)
+		if err != nil {
+			return nil, err
 		}
 
-		currencyAsset, err := kc.GetAsset(currency[0])
+		parsedAmount, err = amount.ToUnits(precision)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		parsedAmount := op.Amount
+	return kc.withdraw(base, op, parsedAmount)
+}
 
-		if currencyAsset.AssetType == proto.KDAData_Fungible {
-			parsedAmount = parsedAmount * math.Pow10(int(currencyAsset.Precision))
+func (kc *kleverChain) withdrawPrecision(op *models.WithdrawOptions) (uint32, error) {
+	currency := strings.Split(op.CurrencyID, "/")
+	if len(currency) > 1 {
+		return 0, fmt.Errorf("invalid KDA ID")
+	}
+
+	return kc.fungiblePrecision(currency[0])
+}
+
+func (kc *kleverChain) withdraw(base *models.BaseTX, op *models.WithdrawOptions, amount int64) (*proto.Transaction, error) {
+
+	var withdrawTX models.WithdrawTXRequest
+
+	switch op.WithdrawType {
+	case models.StakingWithdraw:
+		withdrawTX = models.WithdrawTXRequest{
+			WithdrawType: int32(op.WithdrawType),
+			KDA:          op.KDA,
 		}
 
+	case models.KDAPoolWithdraw:
 		withdrawTX = models.WithdrawTXRequest{
 			WithdrawType: int32(op.WithdrawType),
 			KDA:          op.KDA,
-			Amount:       int64(parsedAmount),
+			Amount:       amount,
 			CurrencyID:   op.CurrencyID,
 		}
 	default:
@@ -0,0 +1,181 @@
+package provider_test
+
+import (
+	"encoding/hex"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/core"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/klever-io/klever-go-sdk/testutil"
+)
+
+const cassettePath = "testdata/cassette.json"
+
+// secretTransport adds an api key to the header and the query of every request,
+// as a client of a key protected node would
+type secretTransport struct {
+	key  string
+	next http.RoundTripper
+}
+
+func (st *secretTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+st.key)
+
+	query := req.URL.Query()
+	query.Set("apikey", st.key)
+	req.URL.RawQuery = query.Encode()
+
+	return st.next.RoundTrip(req)
+}
+
+func newCassetteTestKleverChain(t *testing.T, transport http.RoundTripper) provider.KleverChain {
+	kc, err := provider.NewKleverChain(
+		network.NewNetworkConfig(network.LocalNet),
+		utils.NewHttpClient(time.Second, utils.WithTransport(transport)),
+	)
+	require.Nil(t, err)
+
+	return kc
+}
+
+// recordCassette records the calls of runCassetteCalls made to a fake node
+func recordCassette(t *testing.T, path string, key string) {
+	node, err := testutil.NewFakeNode()
+	require.Nil(t, err)
+	defer node.Close()
+
+	node.ChainID = "100420"
+	node.KAppFee = 500000
+	node.BandwidthFee = 1000000
+	node.SetNonce(offlineSender, 12)
+	node.SetBalance(offlineSender, core.KLV, 1500000000)
+
+	recorder := utils.NewCassetteRecorder(path, nil)
+	kc, err := provider.NewKleverChain(
+		node.NetworkConfig(),
+		utils.NewHttpClient(time.Second, utils.WithTransport(&secretTransport{key: key, next: recorder})),
+	)
+	require.Nil(t, err)
+
+	runCassetteCalls(t, kc)
+	require.Nil(t, recorder.Save())
+}
+
+// runCassetteCalls gets the account, prepares a transfer with its nonce and decodes it
+func runCassetteCalls(t *testing.T, kc provider.KleverChain) {
+	acc, err := kc.GetAccount(offlineSender)
+	require.Nil(t, err)
+	assert.Equal(t, offlineSender, acc.Address)
+	assert.Equal(t, uint64(12), acc.Nonce)
+	assert.Equal(t, int64(1500000000), acc.Balance)
+
+	tx, err := kc.Send(&models.BaseTX{FromAddress: offlineSender, Nonce: acc.Nonce}, offlineReceiver, 1, core.KLV)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(12), tx.GetRawData().GetNonce())
+	assert.Equal(t, []byte("100420"), tx.GetRawData().GetChainID())
+
+	decoded, err := kc.Decode(tx)
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(tx.Hash), decoded.Hash)
+	assert.Equal(t, offlineSender, decoded.Sender)
+	require.Len(t, decoded.Contracts, 1)
+	assert.Equal(t, proto.TXContract_TransferContractType, decoded.Contracts[0].Type)
+}
+
+// newRecordedKleverChain replays the node interactions of testdata/<name>.json. With KLEVER_NODE_URL
+// set the interactions are recorded from that node instead and the file is rewritten when the test ends,
+// the test is skipped while the fixture was never recorded
+func newRecordedKleverChain(t *testing.T, name string) provider.KleverChain {
+	path := filepath.Join("testdata", name+".json")
+
+	nodeURL := os.Getenv("KLEVER_NODE_URL")
+	if len(nodeURL) != 0 {
+		recorder := utils.NewCassetteRecorder(path, nil)
+		t.Cleanup(func() {
+			if !t.Failed() {
+				require.Nil(t, recorder.Save())
+			}
+		})
+
+		kc, err := provider.NewKleverChain(
+			network.NewNetworkConfigCustom(nodeURL, nodeURL, nodeURL),
+			utils.NewHttpClient(10*time.Second, utils.WithTransport(recorder)),
+		)
+		require.Nil(t, err)
+
+		return kc
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		t.Skipf("%s not recorded, run the test with KLEVER_NODE_URL to record it", path)
+	}
+
+	player, err := utils.NewCassettePlayer(path)
+	require.Nil(t, err)
+
+	return newCassetteTestKleverChain(t, player)
+}
+
+// Test_Cassette_Record refreshes testdata/cassette.json, run it with KLEVER_RECORD_CASSETTE=1
+func Test_Cassette_Record(t *testing.T) {
+	if len(os.Getenv("KLEVER_RECORD_CASSETTE")) == 0 {
+		t.Skip("KLEVER_RECORD_CASSETTE not set")
+	}
+
+	recordCassette(t, cassettePath, "record-key")
+}
+
+func Test_Cassette_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recordCassette(t, path, "record-key")
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), "record-key")
+
+	// the node is gone, the replay only needs the cassette and matches any key
+	player, err := utils.NewCassettePlayer(path)
+	require.Nil(t, err)
+	runCassetteCalls(t, newCassetteTestKleverChain(t, &secretTransport{key: "replay-key", next: player}))
+	assert.Equal(t, 0, player.Remaining())
+
+	player, err = utils.NewCassettePlayer(path)
+	require.Nil(t, err)
+	kc := newCassetteTestKleverChain(t, &secretTransport{key: "replay-key", next: player})
+	_, err = kc.Decode(&proto.Transaction{RawData: &proto.Transaction_Raw{Nonce: 12}})
+	assert.Contains(t, err.Error(), "expected GET /address/")
+}
+
+func Test_Cassette_Replay(t *testing.T) {
+	player, err := utils.NewCassettePlayer(cassettePath)
+	require.Nil(t, err)
+
+	// the cassette was recorded with another key, redacted from the file
+	runCassetteCalls(t, newCassetteTestKleverChain(t, &secretTransport{key: "replay-key", next: player}))
+	assert.Equal(t, 0, player.Remaining())
+}
+
+func Test_Cassette_GetAccount(t *testing.T) {
+	player, err := utils.NewCassettePlayer(cassettePath)
+	require.Nil(t, err)
+	kc := newCassetteTestKleverChain(t, &secretTransport{key: "replay-key", next: player})
+
+	_, err = kc.GetAccount(offlineSender)
+	require.Nil(t, err)
+
+	// each interaction is replayed once and in order
+	_, err = kc.GetAccount(offlineSender)
+	assert.Contains(t, err.Error(), "expected POST /transaction/send?apikey=%5BREDACTED%5D as interaction 1, got GET /address/")
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+const contractTypeURLPrefix = "type.googleapis.com/proto."
+
+// Field numbers of the contract messages of the node transaction proto (package proto of
+// github.com/klever-io/klever-go), locked by the golden transactions of provider/testdata/offline
+const (
+	transferToAddressField protowire.Number = 1
+	transferAssetIDField   protowire.Number = 2
+	transferAmountField    protowire.Number = 3
+
+	freezeAssetIDField protowire.Number = 1
+	freezeAmountField  protowire.Number = 2
+
+	unfreezeAssetIDField  protowire.Number = 1
+	unfreezeBucketIDField protowire.Number = 2
+
+	delegateToAddressField protowire.Number = 1
+	delegateBucketIDField  protowire.Number = 2
+
+	undelegateBucketIDField protowire.Number = 1
+
+	withdrawAssetIDField    protowire.Number = 1
+	withdrawTypeField       protowire.Number = 2
+	withdrawAmountField     protowire.Number = 3
+	withdrawCurrencyIDField protowire.Number = 4
+
+	claimTypeField protowire.Number = 1
+	claimIDField   protowire.Number = 2
+
+	setAccountNameField protowire.Number = 1
+
+	voteProposalIDField protowire.Number = 1
+	voteAmountField     protowire.Number = 2
+	voteTypeField       protowire.Number = 3
+
+	// CallValue is a map, each entry is a message with the key as field 1 and the value as field 2
+	scContractTypeField      protowire.Number = 1
+	scContractAddressField   protowire.Number = 2
+	scContractCallValueField protowire.Number = 3
+	mapEntryKeyField         protowire.Number = 1
+	mapEntryValueField       protowire.Number = 2
+)
+
+// defaultContractEncoders returns the encoders of the built-in contract types
+func defaultContractEncoders() map[proto.TXContract_ContractType]ContractEncoder {
+	return map[proto.TXContract_ContractType]ContractEncoder{
+		proto.TXContract_TransferContractType:       encodeTransferContract,
+		proto.TXContract_FreezeContractType:         encodeFreezeContract,
+		proto.TXContract_UnfreezeContractType:       encodeUnfreezeContract,
+		proto.TXContract_DelegateContractType:       encodeDelegateContract,
+		proto.TXContract_UndelegateContractType:     encodeUndelegateContract,
+		proto.TXContract_WithdrawContractType:       encodeWithdrawContract,
+		proto.TXContract_ClaimContractType:          encodeClaimContract,
+		proto.TXContract_UnjailContractType:         encodeUnjailContract,
+		proto.TXContract_SetAccountNameContractType: encodeSetAccountNameContract,
+		proto.TXContract_VoteContractType:           encodeVoteContract,
+		proto.TXContract_SmartContractType:          encodeSmartContract,
+	}
+}
+
+// contractRequest converts the contract to the request model T, contracts built by
+// MultiSend are maps holding the json fields of the model
+func contractRequest[T any](contract interface{}) (T, error) {
+	var request T
+	switch c := contract.(type) {
+	case T:
+		return c, nil
+	case *T:
+		if c == nil {
+			return request, fmt.Errorf("nil contract")
+		}
+		return *c, nil
+	}
+
+	b, err := json.Marshal(contract)
+	if err != nil {
+		return request, err
+	}
+
+	if err := json.Unmarshal(b, &request); err != nil {
+		return request, fmt.Errorf("unexpected contract %T: %w", contract, err)
+	}
+
+	return request, nil
+}
+
+// contractWriter appends proto3 fields in field number order, skipping default values
+type contractWriter struct {
+	b   []byte
+	err error
+}
+
+func (w *contractWriter) bytes(num protowire.Number, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+
+	w.b = protowire.AppendTag(w.b, num, protowire.BytesType)
+	w.b = protowire.AppendBytes(w.b, v)
+}
+
+func (w *contractWriter) varint(num protowire.Number, v uint64) {
+	if v == 0 {
+		return
+	}
+
+	w.b = protowire.AppendTag(w.b, num, protowire.VarintType)
+	w.b = protowire.AppendVarint(w.b, v)
+}
+
+func (w *contractWriter) int64(num protowire.Number, v int64) {
+	w.varint(num, uint64(v))
+}
+
+func (w *contractWriter) address(num protowire.Number, addr string) {
+	if len(addr) == 0 || w.err != nil {
+		return
+	}
+
+	decoded, err := address.NewAddress(addr)
+	if err != nil {
+		w.err = fmt.Errorf("invalid address %s: %w", addr, err)
+		return
+	}
+
+	w.bytes(num, decoded.Bytes())
+}
+
+func (w *contractWriter) hex(num protowire.Number, v string) {
+	if len(v) == 0 || w.err != nil {
+		return
+	}
+
+	decoded, err := hex.DecodeString(v)
+	if err != nil {
+		w.err = fmt.Errorf("invalid hex %s: %w", v, err)
+		return
+	}
+
+	w.bytes(num, decoded)
+}
+
+func (w *contractWriter) any(name string) (*anypb.Any, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	return &anypb.Any{TypeUrl: contractTypeURLPrefix + name, Value: w.b}, nil
+}
+
+func encodeTransferContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.TransferTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.address(transferToAddressField, request.Receiver)
+	w.bytes(transferAssetIDField, []byte(request.KDA))
+	w.int64(transferAmountField, request.Amount)
+
+	return w.any("TransferContract")
+}
+
+func encodeFreezeContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.FreezeTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.bytes(freezeAssetIDField, []byte(request.KDA))
+	w.int64(freezeAmountField, request.Amount)
+
+	return w.any("FreezeContract")
+}
+
+func encodeUnfreezeContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.UnfreezeTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.bytes(unfreezeAssetIDField, []byte(request.KDA))
+	w.hex(unfreezeBucketIDField, request.BucketID)
+
+	return w.any("UnfreezeContract")
+}
+
+func encodeDelegateContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.DelegateTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.address(delegateToAddressField, request.Receiver)
+	w.hex(delegateBucketIDField, request.BucketID)
+
+	return w.any("DelegateContract")
+}
+
+func encodeUndelegateContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.UndelegateTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.hex(undelegateBucketIDField, request.BucketID)
+
+	return w.any("UndelegateContract")
+}
+
+func encodeWithdrawContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.WithdrawTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.bytes(withdrawAssetIDField, []byte(request.KDA))
+	w.int64(withdrawTypeField, int64(request.WithdrawType))
+	w.int64(withdrawAmountField, request.Amount)
+	w.bytes(withdrawCurrencyIDField, []byte(request.CurrencyID))
+
+	return w.any("WithdrawContract")
+}
+
+func encodeClaimContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.ClaimTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.int64(claimTypeField, int64(request.ClaimType))
+	w.bytes(claimIDField, []byte(request.ID))
+
+	return w.any("ClaimContract")
+}
+
+func encodeUnjailContract(contract interface{}) (*anypb.Any, error) {
+	w := &contractWriter{}
+	return w.any("UnjailContract")
+}
+
+func encodeSetAccountNameContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.SetAccountNameTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.bytes(setAccountNameField, []byte(request.Name))
+
+	return w.any("SetAccountNameContract")
+}
+
+func encodeVoteContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.VoteTXRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.varint(voteProposalIDField, request.ProposalID)
+	w.int64(voteAmountField, request.Amount)
+	w.int64(voteTypeField, int64(request.Type))
+
+	return w.any("VoteContract")
+}
+
+func encodeSmartContract(contract interface{}) (*anypb.Any, error) {
+	request, err := contractRequest[models.SmartContractRequest](contract)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &contractWriter{}
+	w.int64(scContractTypeField, int64(request.SCType))
+	w.address(scContractAddressField, request.Address)
+
+	// map entries are written sorted by key, as the deterministic proto marshalizer does,
+	// and always hold both the key and the value even when they are empty or zero
+	kdas := make([]string, 0, len(request.CallValue))
+	for kda := range request.CallValue {
+		kdas = append(kdas, kda)
+	}
+	sort.Strings(kdas)
+
+	for _, kda := range kdas {
+		var entry []byte
+		entry = protowire.AppendTag(entry, mapEntryKeyField, protowire.BytesType)
+		entry = protowire.AppendString(entry, kda)
+		entry = protowire.AppendTag(entry, mapEntryValueField, protowire.VarintType)
+		entry = protowire.AppendVarint(entry, uint64(request.CallValue[kda]))
+
+		w.b = protowire.AppendTag(w.b, scContractCallValueField, protowire.BytesType)
+		w.b = protowire.AppendBytes(w.b, entry)
+	}
+
+	return w.any("SmartContract")
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+// Transaction verification errors, returned wrapped by VerifyTransaction
+var (
+	ErrTXHashMismatch        = errors.New("transaction hash doesn't match its raw data")
+	ErrTXNotSigned           = errors.New("transaction not signed")
+	ErrTXInvalidSignature    = errors.New("invalid transaction signature")
+	ErrTXThresholdNotReached = errors.New("transaction signers weight doesn't reach the permission threshold")
+	ErrTXOperationNotAllowed = errors.New("transaction contract not allowed by the permission")
+)
+
+// ErrUnsupportedContractType is returned by the offline builder for contract types without an encoder
+var ErrUnsupportedContractType = errors.New("unsupported contract type")
+
+// TXResultCodeError is the error form of a transaction result code,
+// any result code can be compared with errors.Is, e.g. errors.Is(err, TXResultCodeError(proto.Transaction_KeyConflict))
+type TXResultCodeError proto.Transaction_TXResultCode
+
+const (
+	ErrTXOutOfFunds         = TXResultCodeError(proto.Transaction_OutOfFunds)
+	ErrTXAccountError       = TXResultCodeError(proto.Transaction_AccountError)
+	ErrTXAssetError         = TXResultCodeError(proto.Transaction_AssetError)
+	ErrTXContractInvalid    = TXResultCodeError(proto.Transaction_ContractInvalid)
+	ErrTXContractNotFound   = TXResultCodeError(proto.Transaction_ContractNotFound)
+	ErrTXFeeInvalid         = TXResultCodeError(proto.Transaction_FeeInvalid)
+	ErrTXParameterInvalid   = TXResultCodeError(proto.Transaction_ParameterInvalid)
+	ErrTXAmountInvalid      = TXResultCodeError(proto.Transaction_AmountInvalid)
+	ErrTXBalanceError       = TXResultCodeError(proto.Transaction_BalanceError)
+	ErrTXKAPPError          = TXResultCodeError(proto.Transaction_KAPPError)
+	ErrTXVMFunctionNotFound = TXResultCodeError(proto.Transaction_VMFunctionNotFound)
+	ErrTXVMWrongSignature   = TXResultCodeError(proto.Transaction_VMFunctionWrongSignature)
+	ErrTXVMUserError        = TXResultCodeError(proto.Transaction_VMUserError)
+	ErrTXVMOutOfGas         = TXResultCodeError(proto.Transaction_VMOutOfGas)
+	ErrTXFail               = TXResultCodeError(proto.Transaction_Fail)
+)
+
+func (e TXResultCodeError) Error() string {
+	return fmt.Sprintf("transaction result code %s", proto.Transaction_TXResultCode(e).String())
+}
+
+// Code returns the proto result code
+func (e TXResultCodeError) Code() proto.Transaction_TXResultCode {
+	return proto.Transaction_TXResultCode(e)
+}
+
+func (e TXResultCodeError) Is(target error) bool {
+	switch proto.Transaction_TXResultCode(e) {
+	case proto.Transaction_OutOfFunds, proto.Transaction_BalanceError:
+		return target == utils.ErrInsufficientFunds
+	case proto.Transaction_AlreadyExists:
+		return target == utils.ErrAlreadyExists
+	}
+
+	return false
+}
+
+// TransactionFailedError is returned when a transaction was included in a block
+// but its execution failed
+type TransactionFailedError struct {
+	Hash       string
+	ResultCode proto.Transaction_TXResultCode
+	// RawResultCode is the result code as returned by the API
+	RawResultCode string
+	Transaction   *models.TransactionAPI
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("transaction %s failed with result code %s", e.Hash, e.RawResultCode)
+}
+
+func (e *TransactionFailedError) Unwrap() error {
+	return TXResultCodeError(e.ResultCode)
+}
+
+func newTransactionFailedError(tx *models.TransactionAPI) *TransactionFailedError {
+	code, exists := proto.Transaction_TXResultCode_value[tx.ResultCode]
+	if !exists {
+		code = int32(proto.Transaction_Fail)
+	}
+
+	return &TransactionFailedError{
+		Hash:          tx.Hash,
+		ResultCode:    proto.Transaction_TXResultCode(code),
+		RawResultCode: tx.ResultCode,
+		Transaction:   tx,
+	}
+}
+
+// BroadcastError is returned when the node refuses a broadcast,
+// it matches the sentinel errors of the utils package with errors.Is
+type BroadcastError struct {
+	URL     string
+	Code    string
+	Message string
+	// Err is the error returned by the http client, if any
+	Err error
+}
+
+func newBroadcastError(url string, err error) *BroadcastError {
+	broadcastErr := &BroadcastError{URL: url, Err: err, Message: err.Error()}
+
+	var httpErr *utils.HTTPError
+	if errors.As(err, &httpErr) {
+		broadcastErr.Code = httpErr.Code
+	}
+
+	return broadcastErr
+}
+
+func (e *BroadcastError) Error() string {
+	return fmt.Sprintf("error broadcasting transcation: %s", e.Message)
+}
+
+func (e *BroadcastError) Unwrap() error {
+	return e.Err
+}
+
+func (e *BroadcastError) Is(target error) bool {
+	return utils.MatchNodeMessage(e.Message, target)
+}
+
+// SCQueryError is returned when a smart contract query ends with a return code other than Ok,
+// VM failures match the result code errors, e.g. errors.Is(err, ErrTXVMUserError)
+type SCQueryError struct {
+	ScAddress     string
+	FuncName      string
+	ReturnCode    string
+	ReturnMessage string
+}
+
+func (e *SCQueryError) Error() string {
+	if len(e.ReturnMessage) == 0 {
+		return fmt.Sprintf("query %s of %s failed with return code %s", e.FuncName, e.ScAddress, e.ReturnCode)
+	}
+
+	return fmt.Sprintf("query %s of %s failed with return code %s: %s", e.FuncName, e.ScAddress, e.ReturnCode, e.ReturnMessage)
+}
+
+func (e *SCQueryError) Is(target error) bool {
+	switch strings.ToLower(e.ReturnCode) {
+	case "function not found":
+		return target == ErrTXVMFunctionNotFound
+	case "function wrong signature":
+		return target == ErrTXVMWrongSignature
+	case "contract not found":
+		return target == ErrTXContractNotFound
+	case "contract invalid":
+		return target == ErrTXContractInvalid
+	case "user error":
+		return target == ErrTXVMUserError
+	case "out of gas":
+		return target == ErrTXVMOutOfGas
+	case "out of funds":
+		return target == ErrTXOutOfFunds || target == utils.ErrInsufficientFunds
+	}
+
+	return false
+}
+
+// SimulationFailedError is returned when the node predicts a transaction will fail,
+// it unwraps to the result code error and matches the sentinel errors of the utils package
+type SimulationFailedError struct {
+	ResultCode proto.Transaction_TXResultCode
+	// RawResultCode is the result code as returned by the node
+	RawResultCode string
+	Message       string
+	// Simulation is nil when the node refused to execute the transaction
+	Simulation *models.SimulationResult
+	// Err is the error returned by the http client, if any
+	Err error
+}
+
+func newSimulationFailedError(simulation *models.SimulationResult) *SimulationFailedError {
+	return &SimulationFailedError{
+		ResultCode:    simulation.Code(),
+		RawResultCode: simulation.ResultCode,
+		Message:       simulation.ReturnMessage,
+		Simulation:    simulation,
+	}
+}
+
+func (e *SimulationFailedError) Error() string {
+	if len(e.Message) == 0 {
+		return fmt.Sprintf("transaction simulation failed with result code %s", e.ResultCode.String())
+	}
+
+	return fmt.Sprintf("transaction simulation failed with result code %s: %s", e.ResultCode.String(), e.Message)
+}
+
+func (e *SimulationFailedError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{TXResultCodeError(e.ResultCode), e.Err}
+	}
+
+	return []error{TXResultCodeError(e.ResultCode)}
+}
+
+func (e *SimulationFailedError) Is(target error) bool {
+	return utils.MatchNodeMessage(e.Message, target)
+}
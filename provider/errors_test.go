@@ -0,0 +1,40 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+func Test_TXResultCodeError(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", provider.TXResultCodeError(proto.Transaction_OutOfFunds))
+
+	assert.True(t, errors.Is(err, provider.ErrTXOutOfFunds))
+	assert.True(t, errors.Is(err, utils.ErrInsufficientFunds))
+	assert.False(t, errors.Is(err, provider.ErrTXKAPPError))
+	assert.Equal(t, "wrapped: transaction result code OutOfFunds", err.Error())
+}
+
+func Test_BroadcastError(t *testing.T) {
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":null,"error":"insufficient funds for fee","code":"internal_issue"}`)
+	})
+
+	_, err := kc.BroadcastTransactionWithContext(context.Background(), &proto.Transaction{})
+	require.NotNil(t, err)
+
+	var broadcastErr *provider.BroadcastError
+	require.True(t, errors.As(err, &broadcastErr))
+	assert.Equal(t, "internal_issue", broadcastErr.Code)
+	assert.True(t, errors.Is(err, utils.ErrInsufficientFunds))
+	assert.Equal(t, "error broadcasting transcation: insufficient funds for fee", err.Error())
+}
@@ -0,0 +1,200 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+)
+
+const exactTestAddress = "klv1velayazgrn6mqaqckt7utk9656h8zu3ex4ln8rx7n8p0vy4fd20qmwh4p5"
+
+// newExactTestKleverChain serves the assets of `precisions` and records the
+// contract of every transaction sent to be prepared
+func newExactTestKleverChain(t *testing.T, precisions map[string]uint32) (provider.KleverChain, func() map[string]interface{}) {
+	var contract map[string]interface{}
+
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/asset/"):
+			id := strings.TrimPrefix(r.URL.Path, "/asset/")
+			precision, exists := precisions[id]
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"error":"asset not found","code":"not_found"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"data":{"asset":{"Precision":%d}}}`, precision)
+		case r.URL.Path == "/transaction/send":
+			body, err := io.ReadAll(r.Body)
+			require.Nil(t, err)
+
+			var request struct {
+				Contract map[string]interface{} `json:"contract"`
+			}
+			require.Nil(t, json.Unmarshal(body, &request))
+			contract = request.Contract
+
+			fmt.Fprint(w, `{"data":{"result":{"RawData":{"Nonce":1}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	return kc, func() map[string]interface{} {
+		sent := contract
+		contract = nil
+		return sent
+	}
+}
+
+func parseTestAmount(t *testing.T, s string) models.Amount {
+	amount, err := models.ParseAmount(s)
+	require.Nil(t, err)
+
+	return amount
+}
+
+func Test_ExactBuilders(t *testing.T) {
+	kc, sent := newExactTestKleverChain(t, map[string]uint32{"USDT-A1B2": 2, "TOKEN-C3D4": 4})
+	base := &models.BaseTX{FromAddress: exactTestAddress, Nonce: 1}
+
+	testCases := []struct {
+		name     string
+		build    func() (*proto.Transaction, error)
+		expected map[string]interface{}
+	}{
+		{
+			name: "send klv",
+			build: func() (*proto.Transaction, error) {
+				return kc.SendExact(base, exactTestAddress, parseTestAmount(t, "1.5"), "KLV")
+			},
+			expected: map[string]interface{}{"amount": 1500000.0, "kda": "KLV"},
+		},
+		{
+			name: "send asset",
+			build: func() (*proto.Transaction, error) {
+				return kc.SendExact(base, exactTestAddress, parseTestAmount(t, "0.05"), "USDT-A1B2")
+			},
+			expected: map[string]interface{}{"amount": 5.0, "kda": "USDT-A1B2"},
+		},
+		{
+			name: "freeze",
+			build: func() (*proto.Transaction, error) {
+				return kc.FreezeExact(base, parseTestAmount(t, "10.0001"), "TOKEN-C3D4")
+			},
+			expected: map[string]interface{}{"amount": 100001.0},
+		},
+		{
+			name: "vote",
+			build: func() (*proto.Transaction, error) {
+				return kc.VoteExact(base, 3, parseTestAmount(t, "2.25"), 0)
+			},
+			expected: map[string]interface{}{"amount": 2250000.0, "proposalId": 3.0},
+		},
+		{
+			name: "asset trigger",
+			build: func() (*proto.Transaction, error) {
+				return kc.AssetTriggerExact(base, "TOKEN-C3D4", provider.Mint, parseTestAmount(t, "0.5"), &models.AssetTriggerOptions{})
+			},
+			expected: map[string]interface{}{"amount": 5000.0, "assetId": "TOKEN-C3D4"},
+		},
+		{
+			name: "deposit",
+			build: func() (*proto.Transaction, error) {
+				op := &models.DepositOptions{DepositType: models.KDAPoolDeposit, KDAID: "TOKEN-C3D4", CurrencyID: "USDT-A1B2"}
+				return kc.DepositExact(base, op, parseTestAmount(t, "7.1"))
+			},
+			expected: map[string]interface{}{"amount": 710.0, "currencyId": "USDT-A1B2"},
+		},
+		{
+			name: "withdraw",
+			build: func() (*proto.Transaction, error) {
+				op := &models.WithdrawOptions{WithdrawType: models.KDAPoolWithdraw, KDA: "TOKEN-C3D4", CurrencyID: "USDT-A1B2"}
+				return kc.WithdrawExact(base, op, parseTestAmount(t, "0.33"))
+			},
+			expected: map[string]interface{}{"amount": 33.0},
+		},
+		{
+			name: "config ito",
+			build: func() (*proto.Transaction, error) {
+				packs := []models.ParsedExactPack{{Kda: "USDT-A1B2", Packs: []models.ParsedExactItemPack{
+					{Amount: parseTestAmount(t, "1"), Price: parseTestAmount(t, "0.99")},
+				}}}
+				return kc.ConfigITOExact(base, "TOKEN-C3D4", exactTestAddress, 1, parseTestAmount(t, "100"), packs)
+			},
+			expected: map[string]interface{}{
+				"maxAmount": 1000000.0,
+				"packInfo": map[string]interface{}{"USDT-A1B2": map[string]interface{}{"packs": []interface{}{
+					map[string]interface{}{"amount": 10000.0, "price": 99.0},
+				}}},
+			},
+		},
+		{
+			name: "buy order",
+			build: func() (*proto.Transaction, error) {
+				return kc.BuyOrderExact(base, "TOKEN-C3D4", "USDT-A1B2", parseTestAmount(t, "3"), parseTestAmount(t, "12.5"), 1)
+			},
+			expected: map[string]interface{}{"amount": 30000.0, "currencyAmount": 1250.0},
+		},
+		{
+			name: "sell order",
+			build: func() (*proto.Transaction, error) {
+				return kc.SellOrderExact(base, "TOKEN-C3D4/1", "KLV", "414243", parseTestAmount(t, "1.25"), parseTestAmount(t, "2"), 0, 0, "")
+			},
+			expected: map[string]interface{}{"price": 1250000.0, "reservePrice": 2000000.0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.build()
+			require.Nil(t, err)
+
+			contract := sent()
+			require.NotNil(t, contract)
+			for field, value := range tc.expected {
+				assert.Equal(t, value, contract[field], field)
+			}
+		})
+	}
+}
+
+func Test_ExactBuilders_Errors(t *testing.T) {
+	kc, sent := newExactTestKleverChain(t, map[string]uint32{"USDT-A1B2": 2})
+	base := &models.BaseTX{FromAddress: exactTestAddress, Nonce: 1}
+
+	_, err := kc.SendExact(base, exactTestAddress, parseTestAmount(t, "0.001"), "USDT-A1B2")
+	assert.Contains(t, err.Error(), "has more than 2 decimals")
+
+	_, err = kc.BuyOrderExact(base, "USDT-A1B2", "KLV", parseTestAmount(t, "1"), parseTestAmount(t, "0.0000001"), 1)
+	assert.Contains(t, err.Error(), "has more than 6 decimals")
+
+	_, err = kc.FreezeExact(base, parseTestAmount(t, "1"), "MISSING-0000")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, sent())
+}
+
+func Test_BuyOrder_CurrencyAmountUnits(t *testing.T) {
+	kc, sent := newExactTestKleverChain(t, map[string]uint32{"TOKEN-C3D4": 4})
+	base := &models.BaseTX{FromAddress: exactTestAddress, Nonce: 1}
+
+	// BuyOrder takes the currency amount in units, BuyOrderExact as a decimal amount
+	_, err := kc.BuyOrder(base, "TOKEN-C3D4", "KLV", 3, 1500000, 1)
+	require.Nil(t, err)
+	assert.Equal(t, 1500000.0, sent()["currencyAmount"])
+
+	_, err = kc.BuyOrderExact(base, "TOKEN-C3D4", "KLV", parseTestAmount(t, "3"), parseTestAmount(t, "1.5"), 1)
+	require.Nil(t, err)
+	assert.Equal(t, 1500000.0, sent()["currencyAmount"])
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options"
+)
+
+// failoverHttpClient reports connection errors and 5xx responses to the
+// network config and sends idempotent calls again to the next endpoint
+type failoverHttpClient struct {
+	utils.HttpClient
+	networkConfig network.FailoverNetworkConfig
+}
+
+func withFailover(networkConfig network.NetworkConfig, httpClient utils.HttpClient) utils.HttpClient {
+	fc, ok := networkConfig.(network.FailoverNetworkConfig)
+	if !ok || httpClient == nil {
+		return httpClient
+	}
+
+	return &failoverHttpClient{HttpClient: httpClient, networkConfig: fc}
+}
+
+func (f *failoverHttpClient) Get(ctx context.Context, url string, target interface{}, options ...http_options.IOptions) error {
+	return f.do(ctx, url, http_options.DoIdempotent(true, options...), func(url string) error {
+		return f.HttpClient.Get(ctx, url, target, options...)
+	})
+}
+
+func (f *failoverHttpClient) Post(ctx context.Context, url string, body string, headers []string, target interface{}, options ...http_options.IOptions) error {
+	return f.do(ctx, url, http_options.DoIdempotent(false, options...), func(url string) error {
+		return f.HttpClient.Post(ctx, url, body, headers, target, options...)
+	})
+}
+
+// do sends the call and, when it's idempotent, sends it again to the next endpoint while
+// the failed ones are reported
+func (f *failoverHttpClient) do(ctx context.Context, url string, idempotent bool, call func(url string) error) error {
+	tried := map[string]bool{url: true}
+
+	err := call(url)
+	for f.report(ctx, url, err) && idempotent {
+		next, ok := f.networkConfig.NextUriWithContext(ctx, url)
+		if !ok || tried[next] {
+			return err
+		}
+
+		url = next
+		tried[url] = true
+		err = call(url)
+	}
+
+	return err
+}
+
+// report marks down the endpoint of url and returns true when err is a connection error or a 5xx response
+func (f *failoverHttpClient) report(ctx context.Context, url string, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var requestErr *utils.RequestError
+	if !errors.As(err, &requestErr) && !errors.Is(err, utils.ErrServerError) {
+		return false
+	}
+
+	f.networkConfig.ReportFailure(url)
+	return true
+}
+
+func (kc *kleverChain) nodeUri(ctx context.Context) string {
+	if fc, ok := kc.networkConfig.(network.FailoverNetworkConfig); ok {
+		return fc.GetNodeUriWithContext(ctx)
+	}
+
+	return kc.networkConfig.GetNodeUri()
+}
+
+func (kc *kleverChain) apiUri(ctx context.Context) string {
+	if fc, ok := kc.networkConfig.(network.FailoverNetworkConfig); ok {
+		return fc.GetAPIUriWithContext(ctx)
+	}
+
+	return kc.networkConfig.GetAPIUri()
+}
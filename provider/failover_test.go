@@ -0,0 +1,90 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+func Test_Failover_SwitchesEndpoint(t *testing.T) {
+	degraded := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer degraded.Close()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"account":{"address":"klv1","nonce":3}}}`)
+	}))
+	defer healthy.Close()
+
+	fc, err := network.NewFailoverNetworkConfig(network.Custom, []string{degraded.URL}, []string{degraded.URL, healthy.URL}, "", nil)
+	require.Nil(t, err)
+
+	kc, err := provider.NewKleverChain(fc, utils.NewHttpClient(time.Second))
+	require.Nil(t, err)
+
+	acc, err := kc.GetAccountWithContext(context.Background(), "klv1")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), acc.Nonce)
+	assert.Equal(t, healthy.URL, fc.GetAPIUri())
+}
+
+func Test_Failover_RetriesCallOnNextEndpoint(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+
+	calls := 0
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/transaction/decode", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"tx":{"hash":"abcd"}}}`)
+	}))
+	defer up.Close()
+
+	fc, err := network.NewFailoverNetworkConfig(network.Custom, []string{down.URL, up.URL}, []string{up.URL}, "", nil)
+	require.Nil(t, err)
+
+	kc, err := provider.NewKleverChain(fc, utils.NewHttpClient(time.Second, utils.WithRetryPolicy(utils.RetryPolicy{MaxAttempts: 2})))
+	require.Nil(t, err)
+
+	tx, err := kc.DecodeWithContext(context.Background(), &proto.Transaction{})
+	require.Nil(t, err)
+	assert.Equal(t, "abcd", tx.Hash)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, up.URL, fc.GetNodeUri())
+}
+
+func Test_Failover_DoesNotResendBroadcast(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+
+	calls := 0
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer up.Close()
+
+	fc, err := network.NewFailoverNetworkConfig(network.Custom, []string{down.URL, up.URL}, []string{up.URL}, "", nil)
+	require.Nil(t, err)
+
+	kc, err := provider.NewKleverChain(fc, utils.NewHttpClient(time.Second))
+	require.Nil(t, err)
+
+	_, err = kc.BroadcastTransactionWithContext(context.Background(), &proto.Transaction{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, calls)
+
+	// the failed node is still skipped by the next call
+	assert.Equal(t, up.URL, fc.GetNodeUri())
+}
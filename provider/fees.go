@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options/options"
+)
+
+// EstimateFees asks the node the fees a transaction will cost before it is broadcast,
+// the transaction doesn't need to be signed. Smart contract calls are executed by the
+// node, the gas used and their return data are part of the estimate
+func (kc *kleverChain) EstimateFees(ctx context.Context, tx *proto.Transaction) (*models.FeesEstimate, error) {
+	if tx == nil || tx.GetRawData() == nil {
+		return nil, fmt.Errorf("transaction without raw data")
+	}
+
+	body, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		Data struct {
+			Fee *models.FeesEstimate `json:"fee"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+
+	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/estimate-fee", kc.nodeUri(ctx)), string(body), nil, &result, options.NewIdempotent(true))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Error) != 0 {
+		return nil, fmt.Errorf("error estimating fees: %s", result.Error)
+	}
+
+	if result.Data.Fee == nil {
+		return nil, fmt.Errorf("node returned no fee estimate")
+	}
+
+	return result.Data.Fee, nil
+}
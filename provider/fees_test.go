@@ -0,0 +1,83 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/core"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+)
+
+func Test_EstimateFees(t *testing.T) {
+	var received proto.Transaction
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/transaction/estimate-fee", r.URL.Path)
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+
+		_, _ = w.Write([]byte(`{"data": {"fee": {
+			"kAppFee": 1000000,
+			"bandwidthFee": 2000000,
+			"kdaFee": {"kda": "KFI", "amount": 30},
+			"gasUsed": 4000,
+			"gasMultiplier": 1,
+			"safetyMargin": 10,
+			"returnData": ["AQ=="],
+			"returnMessage": "ok"
+		}}, "code": "successful"}`))
+	})
+
+	tx := &proto.Transaction{
+		RawData:  &proto.Transaction_Raw{Nonce: 3, Data: [][]byte{[]byte("status")}},
+		GasLimit: 5000,
+	}
+
+	estimate, err := kc.EstimateFees(context.Background(), tx)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(3), received.GetRawData().GetNonce())
+	assert.Equal(t, uint64(5000), received.GetGasLimit())
+
+	assert.Equal(t, &models.KDAFeeEstimate{KDA: "KFI", Amount: 30}, estimate.KDAFee)
+	assert.Equal(t, int64(3000000), estimate.TotalFee())
+	assert.Equal(t, uint64(4000), estimate.GasUsed)
+	assert.Equal(t, uint64(4400), estimate.SuggestedGasLimit())
+
+	hexData, err := estimate.HexReturnData()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"01"}, hexData)
+}
+
+func Test_EstimateFees_Errors(t *testing.T) {
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data": {}, "error": "invalid nonce", "code": "internal_issue"}`))
+	})
+
+	_, err := kc.EstimateFees(context.Background(), &proto.Transaction{RawData: &proto.Transaction_Raw{}})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid nonce")
+
+	_, err = kc.EstimateFees(context.Background(), &proto.Transaction{})
+	assert.NotNil(t, err)
+}
+
+// Test_EstimateFees_Recorded replays the estimate of a transfer recorded from a node
+func Test_EstimateFees_Recorded(t *testing.T) {
+	kc := newRecordedKleverChain(t, "estimate_fee")
+
+	acc, err := kc.GetAccount(offlineSender)
+	require.Nil(t, err)
+
+	tx, err := kc.Send(&models.BaseTX{FromAddress: offlineSender, Nonce: acc.Nonce}, offlineReceiver, 1, core.KLV)
+	require.Nil(t, err)
+
+	estimate, err := kc.EstimateFees(context.Background(), tx)
+	require.Nil(t, err)
+	assert.True(t, estimate.TotalFee() > 0)
+}
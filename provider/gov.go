@@ -34,3 +34,23 @@ func (kc *kleverChain) Vote(base *models.BaseTX, proposalID uint64, amount float
 
 	return kc.PrepareTransaction(data)
 }
+
+func (kc *kleverChain) VoteExact(base *models.BaseTX, proposalID uint64, amount models.Amount, voteType uint64) (*proto.Transaction, error) {
+	parsedAmount, err := amount.ToUnits(6)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := []interface{}{models.VoteTXRequest{
+		Type:       uint32(voteType),
+		ProposalID: proposalID,
+		Amount:     parsedAmount,
+	}}
+
+	data, err := kc.buildRequest(proto.TXContract_VoteContractType, base, contracts)
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.PrepareTransaction(data)
+}
@@ -19,40 +19,57 @@ type KleverChain interface {
 	BroadcastTransactionsWithContext(ctx context.Context, txs []*proto.Transaction) ([]string, error)
 	DecodeWithContext(ctx context.Context, tx *proto.Transaction) (*models.TransactionAPI, error)
 	PrepareTransactionWithContext(ctx context.Context, request *models.SendTXRequest) (*proto.Transaction, error)
+	GetBlockHeightWithContext(ctx context.Context) (uint64, error)
+	WaitForTransaction(ctx context.Context, hash string, opts *models.WaitOptions) (*models.TransactionAPI, error)
 	// Query Account data
 	GetAccount(address string) (*models.Account, error)
 	GetAccountAllowance(address string, kda string) (*models.AccountAllowance, error)
 	GetAsset(assetID string) (*proto.KDAData, error)
 	// Transaction helpers
 	Decode(tx *proto.Transaction) (*models.TransactionAPI, error)
+	EstimateFees(ctx context.Context, tx *proto.Transaction) (*models.FeesEstimate, error)
+	SimulateTransaction(ctx context.Context, tx *proto.Transaction) (*models.SimulationResult, error)
+	VerifyTransactionSignatures(ctx context.Context, tx *proto.Transaction) (*TransactionSignatures, error)
 	GetTransaction(hash string) (*models.TransactionAPI, error)
+	GetBlockHeight() (uint64, error)
 	GetHasher() hasher.Hasher
 	GetMarshalizer() marshal.Marshalizer
+	NewOfflineBuilder() OfflineBuilder
 	// Transfer actions
 	Send(base *models.BaseTX, toAddr string, amount float64, kda string) (*proto.Transaction, error)
 	MultiTransfer(base *models.BaseTX, values []models.ToAmount) (*proto.Transaction, error)
+	SendExact(base *models.BaseTX, toAddr string, amount models.Amount, kda string) (*proto.Transaction, error)
+	MultiTransferExact(base *models.BaseTX, values []models.ToExactAmount) (*proto.Transaction, error)
 	// Asset Actions
 	CreateKDA(base *models.BaseTX, kdaType proto.KDAData_EnumAssetType, op *models.KDAOptions) (*proto.Transaction, error)
 	AssetTrigger(base *models.BaseTX, kdaID string, triggerType AssetTriggerType, op *models.AssetTriggerOptions) (*proto.Transaction, error)
+	AssetTriggerExact(base *models.BaseTX, kdaID string, triggerType AssetTriggerType, amount models.Amount, op *models.AssetTriggerOptions) (*proto.Transaction, error)
 	Deposit(base *models.BaseTX, op *models.DepositOptions) (*proto.Transaction, error)
+	DepositExact(base *models.BaseTX, op *models.DepositOptions, amount models.Amount) (*proto.Transaction, error)
 	Withdraw(base *models.BaseTX, op *models.WithdrawOptions) (*proto.Transaction, error)
+	WithdrawExact(base *models.BaseTX, op *models.WithdrawOptions, amount models.Amount) (*proto.Transaction, error)
 	// Acctount Actions
 	SetAccountName(base *models.BaseTX, name string) (*proto.Transaction, error)
 	SetPermission(base *models.BaseTX, permissions []models.PermissionTXRequest) (*proto.Transaction, error)
 	// Governance Actions
 	Proposal(base *models.BaseTX, description string, parameters map[int32]string, duration uint32) (*proto.Transaction, error)
 	Vote(base *models.BaseTX, proposalID uint64, amount float64, voteType uint64) (*proto.Transaction, error)
+	VoteExact(base *models.BaseTX, proposalID uint64, amount models.Amount, voteType uint64) (*proto.Transaction, error)
 	// Market&ITO Actions
 	ConfigITO(base *models.BaseTX, kdaID, receiverAddress string, status int32, maxAmount float64, packs []models.ParsedPack) (*proto.Transaction, error)
+	ConfigITOExact(base *models.BaseTX, kdaID, receiverAddress string, status int32, maxAmount models.Amount, packs []models.ParsedExactPack) (*proto.Transaction, error)
 	SetITOPrices(base *models.BaseTX, kdaID string, packs []models.ParsedPack) (*proto.Transaction, error)
 	ITOTrigger(base *models.BaseTX, kdaID string, triggerType ITOTriggerType, op *models.ITOTriggerOptions) (*proto.Transaction, error)
 	CreateMarketplace(base *models.BaseTX, name, referralAddr string, referralPercent float64) (*proto.Transaction, error)
 	ConfigMarketplace(base *models.BaseTX, id, name, referralAddr string, referralPercent float64) (*proto.Transaction, error)
 	BuyOrder(base *models.BaseTX, id, currency string, amount float64, currencyAmount float64, buyType int32) (*proto.Transaction, error)
+	BuyOrderExact(base *models.BaseTX, id, currency string, amount models.Amount, currencyAmount models.Amount, buyType int32) (*proto.Transaction, error)
 	SellOrder(base *models.BaseTX, kdaID, currency, mktID string, price, reservePrice float64, endTime int64, mktType int32, message string) (*proto.Transaction, error)
+	SellOrderExact(base *models.BaseTX, kdaID, currency, mktID string, price, reservePrice models.Amount, endTime int64, mktType int32, message string) (*proto.Transaction, error)
 	CancelMarketOrder(base *models.BaseTX, orderID string) (*proto.Transaction, error)
 	// Staking Action
 	Freeze(base *models.BaseTX, amount float64, kda string) (*proto.Transaction, error)
+	FreezeExact(base *models.BaseTX, amount models.Amount, kda string) (*proto.Transaction, error)
 	Unfreeze(base *models.BaseTX, bucketId, kda string) (*proto.Transaction, error)
 	Delegate(base *models.BaseTX, toAddr, bucketId string) (*proto.Transaction, error)
 	Undelegate(base *models.BaseTX, toAddr, bucketId string) (*proto.Transaction, error)
@@ -63,8 +80,12 @@ type KleverChain interface {
 	MultiSend(base *models.BaseTX, contracts []models.AnyContractRequest) (*proto.Transaction, error)
 	// Smart Contract
 	DeploySmartContract(base *models.BaseTX, wasmPath string, payable, payableBySC, upgradeable, readable bool, vmType string, arguments ...string) (*proto.Transaction, error)
+	DeploySmartContractWithOptions(base *models.BaseTX, options ...DeployOption) (*proto.Transaction, error)
 	InvokeSmartContract(base *models.BaseTX, scAddress string, functionToCall string, callValue map[string]int64, arguments ...string) (*proto.Transaction, error)
 	NewScOutputDecoder() VMOutputData
+	NewScInputEncoder() VMInputEncoder
+	QuerySmartContract(ctx context.Context, scAddress string, funcName string, arguments ...string) (*models.SCQueryResult, error)
+	QuerySmartContractDecoded(ctx context.Context, decoder VMOutputData, scAddress string, funcName string, arguments ...string) (interface{}, error)
 	// Network Broadcast
 	BroadcastTransaction(tx *proto.Transaction) (string, error)
 	BroadcastTransactions(txs []*proto.Transaction) ([]string, error)
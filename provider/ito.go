@@ -60,6 +60,68 @@ func (kc *kleverChain) createPackInfo(precision uint32, packs []models.ParsedPac
 	return packInfo, nil
 }
 
+func (kc *kleverChain) ConfigITOExact(base *models.BaseTX, kdaID, receiverAddress string, status int32, maxAmount models.Amount, packs []models.ParsedExactPack) (*proto.Transaction, error) {
+	kda, err := kc.GetAsset(kdaID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedMaxAmount, err := maxAmount.ToUnits(kda.Precision)
+	if err != nil {
+		return nil, err
+	}
+
+	packInfo, err := kc.createExactPackInfo(kda.Precision, packs)
+	if err != nil {
+		return nil, err
+	}
+
+	configITO := models.ConfigITOTXRequest{
+		KDA:             kdaID,
+		ReceiverAddress: receiverAddress,
+		Status:          status,
+		MaxAmount:       parsedMaxAmount,
+		PackInfo:        packInfo,
+	}
+
+	data, err := kc.buildRequest(proto.TXContract_ConfigITOContractType, base, []interface{}{configITO})
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.PrepareTransaction(data)
+}
+
+func (kc *kleverChain) createExactPackInfo(precision uint32, packs []models.ParsedExactPack) (map[string]models.PackInfoRequest, error) {
+	packInfo := make(map[string]models.PackInfoRequest)
+
+	for _, p := range packs {
+		packPrecision, err := kc.getPrecision(p.Kda)
+		if err != nil {
+			return nil, err
+		}
+
+		packItems := make([]models.PackItemRequest, 0)
+		for _, pItem := range p.Packs {
+			parsedItemAmount, err := pItem.Amount.ToUnits(precision)
+			if err != nil {
+				return nil, err
+			}
+
+			parsedItemPrice, err := pItem.Price.ToUnits(packPrecision)
+			if err != nil {
+				return nil, err
+			}
+
+			packItems = append(packItems, models.PackItemRequest{Amount: parsedItemAmount, Price: parsedItemPrice})
+		}
+
+		packInfo[p.Kda] = models.PackInfoRequest{Packs: packItems}
+	}
+
+	return packInfo, nil
+}
+
 func (kc *kleverChain) SetITOPrices(base *models.BaseTX, kdaID string, packs []models.ParsedPack) (*proto.Transaction, error) {
 	return nil, fmt.Errorf("deprecated contract, please use ITOTrigger with Type: SetITOPrices(0)")
 }
@@ -42,6 +42,8 @@ func (kc *kleverChain) ConfigMarketplace(base *models.BaseTX, id, name, referral
 	return kc.PrepareTransaction(data)
 }
 
+// BuyOrder scales `amount` by the precision of `id`, `currencyAmount` is sent as is and
+// must already be in units of the currency, unlike BuyOrderExact
 func (kc *kleverChain) BuyOrder(base *models.BaseTX, id, currency string, amount float64, currencyAmount float64, buyType int32) (*proto.Transaction, error) {
 	parsedAmount := amount
 
@@ -68,6 +70,45 @@ func (kc *kleverChain) BuyOrder(base *models.BaseTX, id, currency string, amount
 	return kc.PrepareTransaction(data)
 }
 
+// BuyOrderExact is the exact precision version of BuyOrder, unlike BuyOrder `currencyAmount`
+// is a decimal amount scaled by the currency precision, e.g. 1.5 KLV is sent as 1500000
+func (kc *kleverChain) BuyOrderExact(base *models.BaseTX, id, currency string, amount models.Amount, currencyAmount models.Amount, buyType int32) (*proto.Transaction, error) {
+	precision, err := kc.getPrecision(id)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAmount, err := amount.ToUnits(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	currencyPrecision, err := kc.getPrecision(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCurrencyAmount, err := currencyAmount.ToUnits(currencyPrecision)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrder := models.BuyTXRequest{
+		BuyType:        buyType,
+		ID:             id,
+		CurrencyID:     currency,
+		Amount:         parsedAmount,
+		CurrencyAmount: parsedCurrencyAmount,
+	}
+
+	data, err := kc.buildRequest(proto.TXContract_BuyContractType, base, []interface{}{buyOrder})
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.PrepareTransaction(data)
+}
+
 func (kc *kleverChain) SellOrder(base *models.BaseTX, kdaID, currency, mktID string, price, reservePrice float64, endTime int64, mktType int32, message string) (*proto.Transaction, error) {
 	precision, err := kc.getPrecision(currency)
 	if err != nil {
@@ -95,6 +136,40 @@ func (kc *kleverChain) SellOrder(base *models.BaseTX, kdaID, currency, mktID str
 	return kc.PrepareTransaction(data)
 }
 
+func (kc *kleverChain) SellOrderExact(base *models.BaseTX, kdaID, currency, mktID string, price, reservePrice models.Amount, endTime int64, mktType int32, message string) (*proto.Transaction, error) {
+	precision, err := kc.getPrecision(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPrice, err := price.ToUnits(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedReservePrice, err := reservePrice.ToUnits(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	sellOrder := models.SellTXRequest{
+		MarketType:    mktType,
+		MarketplaceID: mktID,
+		AssetID:       kdaID,
+		CurrencyID:    currency,
+		Price:         parsedPrice,
+		ReservePrice:  parsedReservePrice,
+		EndTime:       endTime,
+	}
+
+	data, err := kc.buildRequest(proto.TXContract_SellContractType, base, []interface{}{sellOrder})
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.PrepareTransaction(data)
+}
+
 func (kc *kleverChain) CancelMarketOrder(base *models.BaseTX, orderID string) (*proto.Transaction, error) {
 	cancelMarketOrder := models.CancelMarketOrderTXRequest{
 		OrderID: orderID,
@@ -0,0 +1,295 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailoverCooldown    = 30 * time.Second
+	defaultHealthCheckInterval = 15 * time.Second
+	defaultNodeHealthPath      = "/node/status"
+	defaultAPIHealthPath       = "/health"
+)
+
+// FailoverOptions configures NewFailoverNetworkConfig, zero values use the defaults
+type FailoverOptions struct {
+	// Cooldown is how long a failed endpoint is skipped when no health check marks it healthy again
+	Cooldown time.Duration
+	// HealthCheckInterval is the period used by StartHealthChecks
+	HealthCheckInterval time.Duration
+	NodeHealthPath      string
+	APIHealthPath       string
+	HTTPClient          *http.Client
+}
+
+type endpoint struct {
+	uri       string
+	downUntil time.Time
+}
+
+type endpointList struct {
+	endpoints []*endpoint
+}
+
+type failoverNetworkConfig struct {
+	network     Network
+	uriExplorer string
+	options     FailoverOptions
+
+	mut  sync.RWMutex
+	node endpointList
+	api  endpointList
+}
+
+// NewFailoverNetworkConfig creates a NetworkConfig that uses the first healthy endpoint of each
+// ordered list, endpoints reported with connection errors or 5xx responses are skipped until
+// the cooldown expires or a health check succeeds
+func NewFailoverNetworkConfig(
+	network Network,
+	nodeUris []string,
+	apiUris []string,
+	explorerUri string,
+	options *FailoverOptions,
+) (FailoverNetworkConfig, error) {
+	if len(nodeUris) == 0 || len(apiUris) == 0 {
+		return nil, fmt.Errorf("at least one node and one api uri must be provided")
+	}
+
+	fc := &failoverNetworkConfig{
+		network:     network,
+		uriExplorer: explorerUri,
+		node:        newEndpointList(nodeUris),
+		api:         newEndpointList(apiUris),
+	}
+
+	if options != nil {
+		fc.options = *options
+	}
+	if fc.options.Cooldown <= 0 {
+		fc.options.Cooldown = defaultFailoverCooldown
+	}
+	if fc.options.HealthCheckInterval <= 0 {
+		fc.options.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if len(fc.options.NodeHealthPath) == 0 {
+		fc.options.NodeHealthPath = defaultNodeHealthPath
+	}
+	if len(fc.options.APIHealthPath) == 0 {
+		fc.options.APIHealthPath = defaultAPIHealthPath
+	}
+	if fc.options.HTTPClient == nil {
+		fc.options.HTTPClient = &http.Client{Timeout: 5 * time.Second}
+	}
+
+	return fc, nil
+}
+
+func newEndpointList(uris []string) endpointList {
+	list := endpointList{endpoints: make([]*endpoint, 0, len(uris))}
+	for _, uri := range uris {
+		list.endpoints = append(list.endpoints, &endpoint{uri: strings.TrimRight(uri, "/")})
+	}
+
+	return list
+}
+
+// current returns the first endpoint not marked down, or the first one if all are down
+func (l *endpointList) current(now time.Time) string {
+	for _, e := range l.endpoints {
+		if !now.Before(e.downUntil) {
+			return e.uri
+		}
+	}
+
+	return l.endpoints[0].uri
+}
+
+func (l *endpointList) find(url string) *endpoint {
+	for _, e := range l.endpoints {
+		if url == e.uri || strings.HasPrefix(url, e.uri+"/") || strings.HasPrefix(url, e.uri+"?") {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (fc *failoverNetworkConfig) GetNetwork() Network {
+	return fc.network
+}
+
+func (fc *failoverNetworkConfig) GetExplorerUri() string {
+	return fc.uriExplorer
+}
+
+func (fc *failoverNetworkConfig) GetAPIUri() string {
+	fc.mut.RLock()
+	defer fc.mut.RUnlock()
+
+	return fc.api.current(time.Now())
+}
+
+func (fc *failoverNetworkConfig) GetNodeUri() string {
+	fc.mut.RLock()
+	defer fc.mut.RUnlock()
+
+	return fc.node.current(time.Now())
+}
+
+// GetAPIUriWithContext returns the uri pinned in ctx, or the current one pinning it when ctx was created by Pin
+func (fc *failoverNetworkConfig) GetAPIUriWithContext(ctx context.Context) string {
+	if p := pinFromContext(ctx); p != nil {
+		return p.get(&p.api, fc.GetAPIUri)
+	}
+
+	return fc.GetAPIUri()
+}
+
+// GetNodeUriWithContext returns the uri pinned in ctx, or the current one pinning it when ctx was created by Pin
+func (fc *failoverNetworkConfig) GetNodeUriWithContext(ctx context.Context) string {
+	if p := pinFromContext(ctx); p != nil {
+		return p.get(&p.node, fc.GetNodeUri)
+	}
+
+	return fc.GetNodeUri()
+}
+
+// ReportFailure marks down the endpoint that served `url`
+func (fc *failoverNetworkConfig) ReportFailure(url string) {
+	fc.mut.Lock()
+	defer fc.mut.Unlock()
+
+	downUntil := time.Now().Add(fc.options.Cooldown)
+	for _, list := range []*endpointList{&fc.node, &fc.api} {
+		if e := list.find(url); e != nil {
+			e.downUntil = downUntil
+		}
+	}
+}
+
+// NextUriWithContext returns url moved to the endpoint the next call with ctx would use, it returns
+// false when ctx is pinned, url isn't served by a known endpoint or the endpoint didn't change
+func (fc *failoverNetworkConfig) NextUriWithContext(ctx context.Context, url string) (string, bool) {
+	if pinFromContext(ctx) != nil {
+		return "", false
+	}
+
+	fc.mut.RLock()
+	defer fc.mut.RUnlock()
+
+	now := time.Now()
+	for _, list := range []*endpointList{&fc.node, &fc.api} {
+		e := list.find(url)
+		if e == nil {
+			continue
+		}
+
+		if next := list.current(now); next != e.uri {
+			return next + strings.TrimPrefix(url, e.uri), true
+		}
+	}
+
+	return "", false
+}
+
+// CheckHealth requests the health path of every endpoint, marking them up or down
+func (fc *failoverNetworkConfig) CheckHealth(ctx context.Context) {
+	fc.checkList(ctx, &fc.node, fc.options.NodeHealthPath)
+	fc.checkList(ctx, &fc.api, fc.options.APIHealthPath)
+}
+
+func (fc *failoverNetworkConfig) checkList(ctx context.Context, list *endpointList, path string) {
+	fc.mut.RLock()
+	uris := make([]string, 0, len(list.endpoints))
+	for _, e := range list.endpoints {
+		uris = append(uris, e.uri)
+	}
+	fc.mut.RUnlock()
+
+	for i, uri := range uris {
+		healthy := fc.isHealthy(ctx, uri+path)
+		if ctx.Err() != nil {
+			return
+		}
+
+		fc.mut.Lock()
+		if healthy {
+			list.endpoints[i].downUntil = time.Time{}
+		} else {
+			list.endpoints[i].downUntil = time.Now().Add(fc.options.Cooldown)
+		}
+		fc.mut.Unlock()
+	}
+}
+
+func (fc *failoverNetworkConfig) isHealthy(ctx context.Context, url string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false
+	}
+
+	r, err := fc.options.HTTPClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer r.Body.Close()
+
+	return r.StatusCode < 500
+}
+
+// StartHealthChecks runs CheckHealth periodically until ctx is done
+func (fc *failoverNetworkConfig) StartHealthChecks(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(fc.options.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			fc.CheckHealth(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+type pinKey struct{}
+
+type pin struct {
+	mut  sync.Mutex
+	node string
+	api  string
+}
+
+// Pin returns a context where the first node and api uris chosen by a failover config
+// are kept for every following call, e.g. to read a transaction from the node that received it
+func Pin(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pinKey{}, &pin{})
+}
+
+func pinFromContext(ctx context.Context) *pin {
+	if ctx == nil {
+		return nil
+	}
+
+	p, _ := ctx.Value(pinKey{}).(*pin)
+	return p
+}
+
+func (p *pin) get(uri *string, current func() string) string {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	if len(*uri) == 0 {
+		*uri = current()
+	}
+
+	return *uri
+}
@@ -0,0 +1,108 @@
+package network_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailover_ReportFailure(t *testing.T) {
+	fc, err := network.NewFailoverNetworkConfig(
+		network.MainNet,
+		[]string{"http://node-a", "http://node-b/"},
+		[]string{"http://api-a"},
+		"http://explorer",
+		&network.FailoverOptions{Cooldown: time.Hour},
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, "http://node-a", fc.GetNodeUri())
+
+	fc.ReportFailure("http://node-a/transaction/send")
+	assert.Equal(t, "http://node-b", fc.GetNodeUri())
+
+	// every endpoint down falls back to the first one
+	fc.ReportFailure("http://node-b/transaction/send")
+	assert.Equal(t, "http://node-a", fc.GetNodeUri())
+
+	// unknown or partial prefix urls are ignored
+	fc.ReportFailure("http://api-ab/address")
+	assert.Equal(t, "http://api-a", fc.GetAPIUri())
+}
+
+func TestFailover_Pin(t *testing.T) {
+	fc, err := network.NewFailoverNetworkConfig(
+		network.MainNet,
+		[]string{"http://node-a", "http://node-b"},
+		[]string{"http://api-a", "http://api-b"},
+		"",
+		nil,
+	)
+	require.Nil(t, err)
+
+	ctx := network.Pin(context.Background())
+	assert.Equal(t, "http://node-a", fc.GetNodeUriWithContext(ctx))
+
+	fc.ReportFailure("http://node-a/transaction/broadcast")
+	assert.Equal(t, "http://node-a", fc.GetNodeUriWithContext(ctx))
+	assert.Equal(t, "http://node-b", fc.GetNodeUriWithContext(context.Background()))
+}
+
+func TestFailover_NextUri(t *testing.T) {
+	fc, err := network.NewFailoverNetworkConfig(
+		network.MainNet,
+		[]string{"http://node-a", "http://node-b"},
+		[]string{"http://api-a"},
+		"",
+		&network.FailoverOptions{Cooldown: time.Hour},
+	)
+	require.Nil(t, err)
+
+	_, ok := fc.NextUriWithContext(context.Background(), "http://node-a/transaction/send")
+	assert.False(t, ok)
+
+	fc.ReportFailure("http://node-a/transaction/send")
+	next, ok := fc.NextUriWithContext(context.Background(), "http://node-a/transaction/send")
+	assert.True(t, ok)
+	assert.Equal(t, "http://node-b/transaction/send", next)
+
+	// pinned calls keep their endpoint
+	_, ok = fc.NextUriWithContext(network.Pin(context.Background()), "http://node-a/transaction/send")
+	assert.False(t, ok)
+
+	fc.ReportFailure("http://api-a/address")
+	_, ok = fc.NextUriWithContext(context.Background(), "http://api-a/address")
+	assert.False(t, ok)
+}
+
+func TestFailover_CheckHealth(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer down.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer up.Close()
+
+	fc, err := network.NewFailoverNetworkConfig(network.Custom, []string{down.URL, up.URL}, []string{up.URL}, "", nil)
+	require.Nil(t, err)
+
+	fc.CheckHealth(context.Background())
+	assert.Equal(t, up.URL, fc.GetNodeUri())
+
+	status = http.StatusOK
+	fc.CheckHealth(context.Background())
+	assert.Equal(t, down.URL, fc.GetNodeUri())
+}
+
+func TestFailover_Errors(t *testing.T) {
+	_, err := network.NewFailoverNetworkConfig(network.MainNet, nil, []string{"http://api"}, "", nil)
+	assert.NotNil(t, err)
+}
@@ -1,8 +1,20 @@
 package network
 
+import "context"
+
 type NetworkConfig interface {
 	GetNetwork() Network
 	GetAPIUri() string
 	GetNodeUri() string
 	GetExplorerUri() string
 }
+
+type FailoverNetworkConfig interface {
+	NetworkConfig
+	GetAPIUriWithContext(ctx context.Context) string
+	GetNodeUriWithContext(ctx context.Context) string
+	ReportFailure(url string)
+	NextUriWithContext(ctx context.Context, url string) (string, bool)
+	CheckHealth(ctx context.Context)
+	StartHealthChecks(ctx context.Context)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/klever-io/klever-go-sdk/core"
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ContractEncoder converts a contract request model (e.g. models.TransferTXRequest)
+// into the protobuf parameter stored in TXContract
+type ContractEncoder func(contract interface{}) (*anypb.Any, error)
+
+type OfflineBuilder interface {
+	RegisterContractEncoder(contractType proto.TXContract_ContractType, encoder ContractEncoder)
+	BuildTransaction(request *models.SendTXRequest, options *models.OfflineTXOptions) (*proto.Transaction, error)
+}
+
+type offlineBuilder struct {
+	kc *kleverChain
+
+	mutEncoders sync.RWMutex
+	encoders    map[proto.TXContract_ContractType]ContractEncoder
+}
+
+// NewOfflineBuilder creates a transaction builder that never reaches the node, contract
+// parameters are produced by the registered encoders. Only these contract types are
+// encoded out of the box:
+//   - TransferContractType
+//   - FreezeContractType and UnfreezeContractType
+//   - DelegateContractType and UndelegateContractType
+//   - WithdrawContractType and ClaimContractType
+//   - UnjailContractType
+//   - SetAccountNameContractType
+//   - VoteContractType
+//   - SmartContractType
+//
+// Any other contract type, e.g. the ones of CreateKDA, AssetTrigger, ConfigITO, BuyOrder,
+// SellOrder, Deposit, SetPermission or Proposal, fails with ErrUnsupportedContractType
+// unless an encoder is set with RegisterContractEncoder
+func NewOfflineBuilder() (OfflineBuilder, error) {
+	kc, err := NewKleverChain(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.NewOfflineBuilder(), nil
+}
+
+func (kc *kleverChain) NewOfflineBuilder() OfflineBuilder {
+	return &offlineBuilder{
+		kc:       kc,
+		encoders: defaultContractEncoders(),
+	}
+}
+
+// RegisterContractEncoder sets the encoder used for a contract type, replacing
+// any encoder previously registered for it
+func (ob *offlineBuilder) RegisterContractEncoder(contractType proto.TXContract_ContractType, encoder ContractEncoder) {
+	ob.mutEncoders.Lock()
+	defer ob.mutEncoders.Unlock()
+
+	ob.encoders[contractType] = encoder
+}
+
+// BuildTransaction produces the same transaction `PrepareTransaction` would
+// receive from /transaction/send, using the caller supplied network fields
+func (ob *offlineBuilder) BuildTransaction(request *models.SendTXRequest, options *models.OfflineTXOptions) (*proto.Transaction, error) {
+	if request == nil {
+		return nil, fmt.Errorf("invalid request to build transaction")
+	}
+
+	if options == nil || len(options.ChainID) == 0 {
+		return nil, fmt.Errorf("chainID must be provided to build an offline transaction")
+	}
+
+	if len(request.Contracts) == 0 || len(request.Contracts) > core.MaxLenghtOfContracts {
+		return nil, fmt.Errorf("invalid len of contracts to build transaction: %d", len(request.Contracts))
+	}
+
+	sender, err := address.NewAddress(request.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	contracts := make([]*proto.TXContract, 0, len(request.Contracts))
+	for _, c := range request.Contracts {
+		contract, err := ob.encodeContract(proto.TXContract_ContractType(request.Type), c)
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, contract)
+	}
+
+	rawData := &proto.Transaction_Raw{
+		Nonce:        request.Nonce,
+		Sender:       sender.Bytes(),
+		Contract:     contracts,
+		PermissionID: request.PermID,
+		Data:         request.Data,
+		KAppFee:      options.KAppFee,
+		BandwidthFee: options.BandwidthFee,
+		Version:      options.Version,
+		ChainID:      []byte(options.ChainID),
+	}
+
+	if len(request.KDAFee) != 0 {
+		rawData.KDAFee = &proto.Transaction_KDAFee{
+			KDA:    []byte(request.KDAFee),
+			Amount: options.KDAFeeAmount,
+		}
+	}
+
+	hash, err := ob.kc.CalculateHash(rawData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.Transaction{
+		RawData:       rawData,
+		GasLimit:      request.GasLimit,
+		GasMultiplier: request.GasMultiplier,
+		Hash:          hash,
+	}, nil
+}
+
+func (ob *offlineBuilder) encodeContract(
+	txType proto.TXContract_ContractType,
+	contract interface{},
+) (*proto.TXContract, error) {
+	// contracts built by MultiSend carry their own type
+	if m, ok := contract.(map[string]interface{}); ok {
+		contractType, err := parseContractType(m["contractType"])
+		if err != nil {
+			return nil, err
+		}
+
+		txType = contractType
+	}
+
+	ob.mutEncoders.RLock()
+	encoder, exists := ob.encoders[txType]
+	ob.mutEncoders.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedContractType, txType.String())
+	}
+
+	parameter, err := encoder(contract)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s: %w", txType.String(), err)
+	}
+
+	return &proto.TXContract{
+		Type:      txType,
+		Parameter: parameter,
+	}, nil
+}
+
+func parseContractType(v interface{}) (proto.TXContract_ContractType, error) {
+	switch t := v.(type) {
+	case uint32:
+		return proto.TXContract_ContractType(t), nil
+	case float64:
+		return proto.TXContract_ContractType(t), nil
+	case proto.TXContract_ContractType:
+		return t, nil
+	default:
+		return 0, fmt.Errorf("invalid contract type: %v", v)
+	}
+}
@@ -0,0 +1,391 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/tools/hasher"
+	"github.com/klever-io/klever-go-sdk/provider/tools/marshal"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+const (
+	offlineSender   = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+	offlineReceiver = "klv1velayazgrn6mqaqckt7utk9656h8zu3ex4ln8rx7n8p0vy4fd20qmwh4p5"
+)
+
+func newTransferRequest() *models.SendTXRequest {
+	contract := models.TransferTXRequest{Receiver: offlineReceiver, Amount: 290000, KDA: "KLV"}
+
+	return &models.SendTXRequest{
+		Type:      uint32(proto.TXContract_TransferContractType),
+		Sender:    offlineSender,
+		Nonce:     7,
+		Data:      [][]byte{[]byte("offline")},
+		Contract:  contract,
+		Contracts: []interface{}{contract},
+	}
+}
+
+func Test_OfflineBuilder_Transfer(t *testing.T) {
+	kc, err := provider.NewKleverChain(nil, nil)
+	require.Nil(t, err)
+
+	tx, err := kc.NewOfflineBuilder().BuildTransaction(newTransferRequest(), &models.OfflineTXOptions{
+		ChainID:      "100420",
+		Version:      1,
+		KAppFee:      500000,
+		BandwidthFee: 1000000,
+	})
+	require.Nil(t, err)
+
+	raw := tx.GetRawData()
+	assert.Equal(t, uint64(7), raw.GetNonce())
+	assert.Equal(t, "e41b323a571fd955e09cd41660ff4465c3f44693c87f2faea4a0fc408727c8ea", hex.EncodeToString(raw.GetSender()))
+	assert.Equal(t, []byte("100420"), raw.GetChainID())
+	assert.Equal(t, uint32(1), raw.GetVersion())
+	assert.Equal(t, int64(500000), raw.GetKAppFee())
+	assert.Equal(t, int64(1000000), raw.GetBandwidthFee())
+	assert.Nil(t, raw.GetKDAFee())
+	require.Len(t, raw.GetContract(), 1)
+	assert.Equal(t, proto.TXContract_TransferContractType, raw.GetContract()[0].GetType())
+
+	parameter := raw.GetContract()[0].GetParameter()
+	assert.Equal(t, "type.googleapis.com/proto.TransferContract", parameter.GetTypeUrl())
+	assert.Equal(t, map[protowire.Number]interface{}{
+		1: "667fd274481cf5b07418b2fdc5d8baa6ae717239357f338cde99c2f612a96a9e",
+		2: "4b4c56",
+		3: uint64(290000),
+	}, decodeTestFields(t, parameter.GetValue()))
+
+	rawBytes, err := kc.GetMarshalizer().Marshal(raw)
+	require.Nil(t, err)
+	assert.Equal(t, kc.GetHasher().Compute(string(rawBytes)), tx.Hash)
+}
+
+// decodeTestFields returns the fields of a proto message, bytes fields hex encoded
+func decodeTestFields(t *testing.T, b []byte) map[protowire.Number]interface{} {
+	fields := make(map[protowire.Number]interface{})
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.True(t, n > 0)
+		b = b[n:]
+
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			require.True(t, n > 0)
+			fields[num] = v
+			b = b[n:]
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			require.True(t, n > 0)
+			fields[num] = hex.EncodeToString(v)
+			b = b[n:]
+		default:
+			t.Fatalf("unexpected wire type %d", typ)
+		}
+	}
+
+	return fields
+}
+
+func Test_OfflineBuilder_BuiltinContracts(t *testing.T) {
+	builder, err := provider.NewOfflineBuilder()
+	require.Nil(t, err)
+
+	build := func(contractType proto.TXContract_ContractType, contract interface{}) (string, map[protowire.Number]interface{}) {
+		tx, err := builder.BuildTransaction(&models.SendTXRequest{
+			Type:      uint32(contractType),
+			Sender:    offlineSender,
+			Contracts: []interface{}{contract},
+		}, &models.OfflineTXOptions{ChainID: "108"})
+		require.Nil(t, err)
+
+		parameter := tx.GetRawData().GetContract()[0].GetParameter()
+		return parameter.GetTypeUrl(), decodeTestFields(t, parameter.GetValue())
+	}
+
+	bucketID := "0a0b0c"
+
+	typeURL, fields := build(proto.TXContract_FreezeContractType, models.FreezeTXRequest{Amount: 10, KDA: "KFI"})
+	assert.Equal(t, "type.googleapis.com/proto.FreezeContract", typeURL)
+	assert.Equal(t, map[protowire.Number]interface{}{1: "4b4649", 2: uint64(10)}, fields)
+
+	_, fields = build(proto.TXContract_UnfreezeContractType, &models.UnfreezeTXRequest{KDA: "KLV", BucketID: bucketID})
+	assert.Equal(t, map[protowire.Number]interface{}{1: "4b4c56", 2: bucketID}, fields)
+
+	_, fields = build(proto.TXContract_DelegateContractType, models.DelegateTXRequest{Receiver: offlineReceiver, BucketID: bucketID})
+	assert.Equal(t, map[protowire.Number]interface{}{1: "667fd274481cf5b07418b2fdc5d8baa6ae717239357f338cde99c2f612a96a9e", 2: bucketID}, fields)
+
+	_, fields = build(proto.TXContract_UndelegateContractType, models.UndelegateTXRequest{BucketID: bucketID})
+	assert.Equal(t, map[protowire.Number]interface{}{1: bucketID}, fields)
+
+	_, fields = build(proto.TXContract_WithdrawContractType, models.WithdrawTXRequest{KDA: "KLV", WithdrawType: 1, Amount: 5, CurrencyID: "KFI"})
+	assert.Equal(t, map[protowire.Number]interface{}{1: "4b4c56", 2: uint64(1), 3: uint64(5), 4: "4b4649"}, fields)
+
+	_, fields = build(proto.TXContract_ClaimContractType, models.ClaimTXRequest{ClaimType: 2, ID: "KLV"})
+	assert.Equal(t, map[protowire.Number]interface{}{1: uint64(2), 2: "4b4c56"}, fields)
+
+	typeURL, fields = build(proto.TXContract_UnjailContractType, models.UnjailTXRequest{})
+	assert.Equal(t, "type.googleapis.com/proto.UnjailContract", typeURL)
+	assert.Empty(t, fields)
+
+	_, fields = build(proto.TXContract_SetAccountNameContractType, models.SetAccountNameTXRequest{Name: "klv"})
+	assert.Equal(t, map[protowire.Number]interface{}{1: "6b6c76"}, fields)
+
+	_, fields = build(proto.TXContract_VoteContractType, models.VoteTXRequest{Type: 1, ProposalID: 3, Amount: 100})
+	assert.Equal(t, map[protowire.Number]interface{}{1: uint64(3), 2: uint64(100), 3: uint64(1)}, fields)
+
+	// negative int64 values use the ten bytes two's complement varint
+	_, fields = build(proto.TXContract_TransferContractType, models.TransferTXRequest{Receiver: offlineReceiver, Amount: -1, KDA: "KLV"})
+	assert.Equal(t, uint64(math.MaxUint64), fields[3])
+
+	typeURL, fields = build(proto.TXContract_SmartContractType, models.SmartContractRequest{
+		Address:   offlineReceiver,
+		CallValue: map[string]int64{"KLV": 1, "KFI": 2},
+	})
+	assert.Equal(t, "type.googleapis.com/proto.SmartContract", typeURL)
+	// map entries sorted by key, KFI before KLV
+	assert.Equal(t, map[protowire.Number]interface{}{
+		2: "667fd274481cf5b07418b2fdc5d8baa6ae717239357f338cde99c2f612a96a9e",
+		3: "0a034b4c5610" + "01",
+	}, fields)
+
+	_, err = builder.BuildTransaction(&models.SendTXRequest{
+		Type:      uint32(proto.TXContract_UndelegateContractType),
+		Sender:    offlineSender,
+		Contracts: []interface{}{models.UndelegateTXRequest{BucketID: "not hex"}},
+	}, &models.OfflineTXOptions{ChainID: "108"})
+	assert.NotNil(t, err)
+}
+
+// offlineGolden is the expected transaction of one of offlineGoldenCases. Source is "node"
+// for transactions recorded with Test_OfflineBuilder_RecordGolden, and "protobuf" for the ones
+// marshalled by the Go protobuf library from the field numbers, not yet recorded from a node
+type offlineGolden struct {
+	Source  string `json:"source"`
+	RawData string `json:"rawData"`
+	Hash    string `json:"hash"`
+}
+
+type offlineGoldenCase struct {
+	name    string
+	request *models.SendTXRequest
+}
+
+func newOfflineGoldenCase(name string, contractType proto.TXContract_ContractType, contract interface{}) offlineGoldenCase {
+	return offlineGoldenCase{
+		name: name,
+		request: &models.SendTXRequest{
+			Type:      uint32(contractType),
+			Sender:    offlineSender,
+			Nonce:     7,
+			Contract:  contract,
+			Contracts: []interface{}{contract},
+		},
+	}
+}
+
+func offlineGoldenCases() []offlineGoldenCase {
+	kdaFee := newTransferRequest()
+	kdaFee.KDAFee = "KFI"
+
+	return []offlineGoldenCase{
+		{name: "transfer", request: newTransferRequest()},
+		{name: "transfer_kda_fee", request: kdaFee},
+		newOfflineGoldenCase("freeze", proto.TXContract_FreezeContractType, models.FreezeTXRequest{Amount: 1000000, KDA: "KLV"}),
+		newOfflineGoldenCase("unfreeze", proto.TXContract_UnfreezeContractType, models.UnfreezeTXRequest{KDA: "KFI", BucketID: "0a0b0c"}),
+		newOfflineGoldenCase("delegate", proto.TXContract_DelegateContractType, models.DelegateTXRequest{Receiver: offlineReceiver, BucketID: "0a0b0c"}),
+		newOfflineGoldenCase("undelegate", proto.TXContract_UndelegateContractType, models.UndelegateTXRequest{BucketID: "0a0b0c"}),
+		newOfflineGoldenCase("withdraw", proto.TXContract_WithdrawContractType, models.WithdrawTXRequest{KDA: "KLV", WithdrawType: 1, Amount: 5, CurrencyID: "KFI"}),
+		newOfflineGoldenCase("claim", proto.TXContract_ClaimContractType, models.ClaimTXRequest{ClaimType: 2, ID: "KLV"}),
+		newOfflineGoldenCase("unjail", proto.TXContract_UnjailContractType, models.UnjailTXRequest{}),
+		newOfflineGoldenCase("set_account_name", proto.TXContract_SetAccountNameContractType, models.SetAccountNameTXRequest{Name: "offline"}),
+		newOfflineGoldenCase("vote", proto.TXContract_VoteContractType, models.VoteTXRequest{Type: 1, ProposalID: 3, Amount: 100}),
+		newOfflineGoldenCase("smart_contract", proto.TXContract_SmartContractType, models.SmartContractRequest{
+			SCType:    int32(utils.SmartContractInvoke),
+			Address:   offlineReceiver,
+			CallValue: map[string]int64{"KLV": 1000000, "KFI": 0},
+		}),
+	}
+}
+
+func offlineGoldenPath(name string) string {
+	return filepath.Join("testdata", "offline", name+".json")
+}
+
+// Test_OfflineBuilder_Golden builds the requests of offlineGoldenCases with the network fields
+// of the golden transactions, raw data and hash must match them byte for byte
+func Test_OfflineBuilder_Golden(t *testing.T) {
+	builder, err := provider.NewOfflineBuilder()
+	require.Nil(t, err)
+	h, err := hasher.NewHasher()
+	require.Nil(t, err)
+	marshalizer := marshal.NewProtoMarshalizer()
+
+	for _, tc := range offlineGoldenCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := os.ReadFile(offlineGoldenPath(tc.name))
+			require.Nil(t, err)
+
+			var golden offlineGolden
+			require.Nil(t, json.Unmarshal(data, &golden))
+
+			goldenRaw, err := hex.DecodeString(golden.RawData)
+			require.Nil(t, err)
+			raw := &proto.Transaction_Raw{}
+			require.Nil(t, marshalizer.Unmarshal(raw, goldenRaw))
+
+			tx, err := builder.BuildTransaction(tc.request, &models.OfflineTXOptions{
+				ChainID:      string(raw.GetChainID()),
+				Version:      raw.GetVersion(),
+				KAppFee:      raw.GetKAppFee(),
+				BandwidthFee: raw.GetBandwidthFee(),
+				KDAFeeAmount: raw.GetKDAFee().GetAmount(),
+			})
+			require.Nil(t, err)
+
+			offlineRaw, err := marshalizer.Marshal(tx.GetRawData())
+			require.Nil(t, err)
+			assert.Equal(t, golden.RawData, hex.EncodeToString(offlineRaw))
+
+			hash, err := provider.CalculateHash(h, marshalizer, tx.GetRawData())
+			require.Nil(t, err)
+			assert.Equal(t, golden.Hash, hex.EncodeToString(hash))
+			assert.Equal(t, golden.Hash, hex.EncodeToString(tx.Hash))
+		})
+	}
+}
+
+// Test_OfflineBuilder_RecordGolden refreshes the golden transactions with the ones prepared by
+// the node at KLEVER_NODE_URL, e.g. KLEVER_NODE_URL=https://node.testnet.klever.org
+func Test_OfflineBuilder_RecordGolden(t *testing.T) {
+	nodeURL := os.Getenv("KLEVER_NODE_URL")
+	if len(nodeURL) == 0 {
+		t.Skip("KLEVER_NODE_URL not set")
+	}
+
+	httpClient := utils.NewHttpClient(10 * time.Second)
+	marshalizer := marshal.NewProtoMarshalizer()
+
+	for _, tc := range offlineGoldenCases() {
+		body, err := json.Marshal(tc.request)
+		require.Nil(t, err)
+
+		result := struct {
+			Data struct {
+				Transaction *proto.Transaction `json:"result"`
+			} `json:"data"`
+		}{}
+		require.Nil(t, httpClient.Post(context.Background(), nodeURL+"/transaction/send", string(body), nil, &result))
+
+		require.NotNil(t, result.Data.Transaction)
+		rawData, err := marshalizer.Marshal(result.Data.Transaction.GetRawData())
+		require.Nil(t, err)
+
+		data, err := json.MarshalIndent(offlineGolden{
+			Source:  "node",
+			RawData: hex.EncodeToString(rawData),
+			Hash:    hex.EncodeToString(result.Data.Transaction.Hash),
+		}, "", "  ")
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(offlineGoldenPath(tc.name), append(data, '\n'), 0644))
+	}
+}
+
+func Test_OfflineBuilder_Gas(t *testing.T) {
+	builder, err := provider.NewOfflineBuilder()
+	require.Nil(t, err)
+
+	request := newTransferRequest()
+	request.GasLimit = 50000
+	request.GasMultiplier = 2
+
+	tx, err := builder.BuildTransaction(request, &models.OfflineTXOptions{ChainID: "100420", Version: 1})
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(50000), tx.GetGasLimit())
+	assert.Equal(t, uint64(2), tx.GetGasMultiplier())
+
+	// gas is not part of the signed raw data
+	withoutGas, err := builder.BuildTransaction(newTransferRequest(), &models.OfflineTXOptions{ChainID: "100420", Version: 1})
+	require.Nil(t, err)
+	assert.Equal(t, withoutGas.Hash, tx.Hash)
+}
+
+func Test_OfflineBuilder_MultiSend_ContractTypes(t *testing.T) {
+	builder, err := provider.NewOfflineBuilder()
+	require.Nil(t, err)
+
+	var encoded []proto.TXContract_ContractType
+	encoder := func(ct proto.TXContract_ContractType) provider.ContractEncoder {
+		return func(contract interface{}) (*anypb.Any, error) {
+			encoded = append(encoded, ct)
+			return &anypb.Any{}, nil
+		}
+	}
+	builder.RegisterContractEncoder(proto.TXContract_TransferContractType, encoder(proto.TXContract_TransferContractType))
+	builder.RegisterContractEncoder(proto.TXContract_FreezeContractType, encoder(proto.TXContract_FreezeContractType))
+
+	transfer, err := models.AnyContractRequest{
+		ContractType: uint32(proto.TXContract_TransferContractType),
+		Contract:     models.TransferTXRequest{Receiver: offlineReceiver, Amount: 1},
+	}.PrepareToSend()
+	require.Nil(t, err)
+
+	freeze, err := models.AnyContractRequest{
+		ContractType: uint32(proto.TXContract_FreezeContractType),
+		Contract:     models.FreezeTXRequest{Amount: 1, KDA: "KLV"},
+	}.PrepareToSend()
+	require.Nil(t, err)
+
+	tx, err := builder.BuildTransaction(&models.SendTXRequest{
+		Sender:    offlineSender,
+		Contracts: []interface{}{transfer, freeze},
+		KDAFee:    "KFI",
+	}, &models.OfflineTXOptions{ChainID: "108", KDAFeeAmount: 10})
+	require.Nil(t, err)
+
+	assert.Equal(t, []proto.TXContract_ContractType{
+		proto.TXContract_TransferContractType,
+		proto.TXContract_FreezeContractType,
+	}, encoded)
+	assert.Equal(t, []byte("KFI"), tx.GetRawData().GetKDAFee().GetKDA())
+	assert.Equal(t, int64(10), tx.GetRawData().GetKDAFee().GetAmount())
+}
+
+func Test_OfflineBuilder_Errors(t *testing.T) {
+	builder, err := provider.NewOfflineBuilder()
+	require.Nil(t, err)
+
+	_, err = builder.BuildTransaction(newTransferRequest(), nil)
+	assert.Contains(t, err.Error(), "chainID must be provided")
+
+	request := newTransferRequest()
+	request.Type = uint32(proto.TXContract_CreateAssetContractType)
+	request.Contracts = []interface{}{models.CreateAssetTXRequest{Name: "offline"}}
+	_, err = builder.BuildTransaction(request, &models.OfflineTXOptions{ChainID: "108"})
+	assert.True(t, errors.Is(err, provider.ErrUnsupportedContractType))
+	assert.Contains(t, err.Error(), "CreateAssetContractType")
+
+	request = newTransferRequest()
+	request.Sender = "klv1invalid"
+	_, err = builder.BuildTransaction(request, &models.OfflineTXOptions{ChainID: "108"})
+	assert.Contains(t, err.Error(), "invalid sender address")
+}
@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/klever-io/klever-go-sdk/models"
 	"github.com/klever-io/klever-go-sdk/models/proto"
 )
 
 func (kc *kleverChain) SetPermission(base *models.BaseTX, permissions []models.PermissionTXRequest) (*proto.Transaction, error) {
+	if len(permissions) == 0 {
+		return nil, fmt.Errorf("no permissions to set")
+	}
+
+	for i := range permissions {
+		if err := permissions[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	contracts := []interface{}{models.UpdateAccountPermissionTXRequest{
 		Permissions: permissions,
@@ -0,0 +1,29 @@
+package provider_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/klever-io/klever-go-sdk/models"
+)
+
+func Test_SetPermission_Validates_Before_Request(t *testing.T) {
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+
+	base := &models.BaseTX{FromAddress: encoderTestAddress, Nonce: 1}
+
+	_, err := kc.SetPermission(base, nil)
+	assert.NotNil(t, err)
+
+	_, err = kc.SetPermission(base, []models.PermissionTXRequest{{
+		Type:           models.PermissionTypeOwner,
+		PermissionName: "owner",
+		Threshold:      2,
+		Signers:        []models.SignerTXRequest{{Address: encoderTestAddress, Weight: 1}},
+	}})
+	assert.NotNil(t, err)
+}
@@ -25,7 +25,7 @@ func NewKleverChain(network network.NetworkConfig, httpClient utils.HttpClient)
 
 	return &kleverChain{
 		networkConfig: network,
-		httpClient:    httpClient,
+		httpClient:    withFailover(network, httpClient),
 		hasher:        hasher,
 		marshalizer:   marshalizer,
 	}, nil
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+// SCBundle is the `.kleversc.json` file written by the contract build, with the code and the abi
+type SCBundle struct {
+	BuildInfo json.RawMessage `json:"buildInfo,omitempty"`
+	Abi       json.RawMessage `json:"abi"`
+	Size      int             `json:"size"`
+	Code      string          `json:"code"`
+}
+
+// LoadSCBundle reads a `.kleversc.json` bundle
+func LoadSCBundle(r io.Reader) (*SCBundle, error) {
+	bundle := &SCBundle{}
+	if err := json.NewDecoder(r).Decode(bundle); err != nil {
+		return nil, fmt.Errorf("invalid contract bundle: %w", err)
+	}
+
+	if len(bundle.Code) == 0 {
+		return nil, fmt.Errorf("contract bundle without code")
+	}
+
+	if _, err := bundle.Wasm(); err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// LoadSCBundleFile reads a `.kleversc.json` bundle from path
+func LoadSCBundleFile(path string) (*SCBundle, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadSCBundle(file)
+}
+
+// Wasm returns the contract code
+func (b *SCBundle) Wasm() ([]byte, error) {
+	code, err := hex.DecodeString(b.Code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract bundle code: %w", err)
+	}
+
+	if b.Size != 0 && b.Size != len(code) {
+		return nil, fmt.Errorf("contract bundle code has %d bytes, expected %d", len(code), b.Size)
+	}
+
+	return code, nil
+}
+
+// AbiReader returns the bundled abi, to be loaded on VMInputEncoder and VMOutputData
+func (b *SCBundle) AbiReader() io.Reader {
+	return bytes.NewReader(b.Abi)
+}
+
+type deployConfig struct {
+	code      []byte
+	metadata  SCMetadata
+	vmType    string
+	arguments []string
+	err       error
+}
+
+// DeployOption configures DeploySmartContractWithOptions
+type DeployOption func(*deployConfig)
+
+// WithCode sets the contract wasm
+func WithCode(code []byte) DeployOption {
+	return func(c *deployConfig) {
+		c.code = code
+	}
+}
+
+// WithCodeReader reads the contract wasm from r
+func WithCodeReader(r io.Reader) DeployOption {
+	return func(c *deployConfig) {
+		code, err := io.ReadAll(r)
+		if err != nil {
+			c.err = fmt.Errorf("failed to read code: %w", err)
+			return
+		}
+		c.code = code
+	}
+}
+
+// WithCodePath reads the contract wasm from a file
+func WithCodePath(path string) DeployOption {
+	return func(c *deployConfig) {
+		if path == "" {
+			c.err = fmt.Errorf("invalid file path provided: %s", path)
+			return
+		}
+
+		code, err := os.ReadFile(path)
+		if err != nil {
+			c.err = fmt.Errorf("failed to read file: %w", err)
+			return
+		}
+		c.code = code
+	}
+}
+
+// WithBundle sets the contract wasm from a loaded `.kleversc.json` bundle
+func WithBundle(bundle *SCBundle) DeployOption {
+	return func(c *deployConfig) {
+		code, err := bundle.Wasm()
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.code = code
+	}
+}
+
+// WithBundlePath sets the contract wasm from a `.kleversc.json` bundle file
+func WithBundlePath(path string) DeployOption {
+	return func(c *deployConfig) {
+		bundle, err := LoadSCBundleFile(path)
+		if err != nil {
+			c.err = err
+			return
+		}
+		WithBundle(bundle)(c)
+	}
+}
+
+// WithMetadata sets the contract metadata flags, none are set by default
+func WithMetadata(metadata SCMetadata) DeployOption {
+	return func(c *deployConfig) {
+		c.metadata = metadata
+	}
+}
+
+// WithVMType overrides the default vm type, only use it if you are very sure of what you are doing
+func WithVMType(vmType string) DeployOption {
+	return func(c *deployConfig) {
+		c.vmType = vmType
+	}
+}
+
+// WithArguments sets the constructor arguments in the "type:value" format of InvokeSmartContract,
+// use EncodedArguments.Arguments for arguments from VMInputEncoder
+func WithArguments(arguments ...string) DeployOption {
+	return func(c *deployConfig) {
+		c.arguments = append(c.arguments, arguments...)
+	}
+}
+
+func newDeployConfig(options []DeployOption) (*deployConfig, error) {
+	config := &deployConfig{vmType: utils.DefaultVMType}
+	for _, opt := range options {
+		opt(config)
+		if config.err != nil {
+			return nil, config.err
+		}
+	}
+
+	if len(config.code) == 0 {
+		return nil, fmt.Errorf("no contract code provided")
+	}
+
+	if len(config.vmType) == 0 {
+		config.vmType = utils.DefaultVMType
+	}
+
+	return config, nil
+}
+
+func (c *deployConfig) message() string {
+	return fmt.Sprintf("%s@%s@%04X", hex.EncodeToString(c.code), c.vmType, c.metadata.ToBytes())
+}
+
+// DeploySmartContractWithOptions builds a deploy transaction, the code must be set by one of
+// WithCode, WithCodeReader, WithCodePath, WithBundle or WithBundlePath
+func (kc *kleverChain) DeploySmartContractWithOptions(base *models.BaseTX, options ...DeployOption) (*proto.Transaction, error) {
+	config, err := newDeployConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.HandleSmartContracts(
+		withSCData(base, config.message()),
+		int32(utils.SmartContractDeploy),
+		"",
+		map[string]int64{},
+		config.arguments...,
+	)
+}
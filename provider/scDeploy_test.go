@@ -0,0 +1,111 @@
+package provider_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/klever-io/klever-go-sdk/testutil"
+)
+
+const lotteryBundlePath = "../cmd/demo/smartContracts/scFiles/lottery-kda.kleversc.json"
+
+func newFakeNodeKleverChain(t *testing.T) provider.KleverChain {
+	node, err := testutil.NewFakeNode()
+	require.Nil(t, err)
+	t.Cleanup(node.Close)
+
+	kc, err := provider.NewKleverChain(node.NetworkConfig(), utils.NewHttpClient(time.Second))
+	require.Nil(t, err)
+
+	return kc
+}
+
+func Test_Load_SC_Bundle(t *testing.T) {
+	bundle, err := provider.LoadSCBundleFile(lotteryBundlePath)
+	require.Nil(t, err)
+
+	code, err := bundle.Wasm()
+	require.Nil(t, err)
+
+	wasm, err := os.ReadFile("../cmd/demo/smartContracts/scFiles/lottery-kda.wasm")
+	require.Nil(t, err)
+	assert.Equal(t, wasm, code)
+
+	decoder := provider.NewVMOutputHandler()
+	require.Nil(t, decoder.LoadAbi(bundle.AbiReader()))
+
+	_, err = provider.LoadSCBundle(strings.NewReader(`{"abi": {}, "size": 3, "code": "0061"}`))
+	assert.NotNil(t, err)
+
+	_, err = provider.LoadSCBundle(strings.NewReader(`{"abi": {}}`))
+	assert.NotNil(t, err)
+}
+
+func Test_Deploy_Smart_Contract_With_Options(t *testing.T) {
+	kc := newFakeNodeKleverChain(t)
+	decoder := newLotteryDecoder(t)
+
+	wasm, err := os.ReadFile("../cmd/demo/smartContracts/scFiles/lottery-kda.wasm")
+	require.Nil(t, err)
+
+	metadata := provider.SCMetadata{Upgradeable: true, Readable: true}
+
+	testCases := []struct {
+		name   string
+		option provider.DeployOption
+	}{
+		{name: "Bytes", option: provider.WithCode(wasm)},
+		{name: "Reader", option: provider.WithCodeReader(bytes.NewReader(wasm))},
+		{name: "Path", option: provider.WithCodePath("../cmd/demo/smartContracts/scFiles/lottery-kda.wasm")},
+		{name: "Bundle", option: provider.WithBundlePath(lotteryBundlePath)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			base := &models.BaseTX{FromAddress: encoderTestAddress, Nonce: 1}
+			tx, err := kc.DeploySmartContractWithOptions(base, tc.option, provider.WithMetadata(metadata))
+			require.Nil(t, err)
+
+			require.Len(t, tx.RawData.Data, 1)
+			assert.Equal(t, hex.EncodeToString(wasm)+"@0500@0500", string(tx.RawData.Data[0]))
+
+			inv, err := decoder.DecodeInvocationData(utils.SmartContractDeploy, string(tx.RawData.Data[0]))
+			require.Nil(t, err)
+			assert.Equal(t, utils.SmartContractDeploy, inv.SCType)
+			assert.Equal(t, &metadata, inv.Metadata)
+		})
+	}
+
+	_, err = kc.DeploySmartContractWithOptions(&models.BaseTX{FromAddress: encoderTestAddress})
+	assert.NotNil(t, err)
+
+	_, err = kc.DeploySmartContractWithOptions(&models.BaseTX{FromAddress: encoderTestAddress}, provider.WithCodePath("missing.wasm"))
+	assert.NotNil(t, err)
+}
+
+func Test_Smart_Contract_Keeps_Base_Messages(t *testing.T) {
+	kc := newFakeNodeKleverChain(t)
+
+	base := &models.BaseTX{FromAddress: encoderTestAddress, Nonce: 1, Message: []string{"memo"}}
+	tx, err := kc.InvokeSmartContract(base, queryTestScAddress, "play", nil, "u32:7")
+	require.Nil(t, err)
+
+	require.Len(t, tx.RawData.Data, 2)
+	assert.Equal(t, "play@07", string(tx.RawData.Data[0]))
+	assert.Equal(t, "memo", string(tx.RawData.Data[1]))
+	assert.Equal(t, []string{"memo"}, base.Message)
+
+	_, err = kc.DeploySmartContractWithOptions(base, provider.WithCode([]byte{1}))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"memo"}, base.Message)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options/options"
+)
+
+// SimulateTransaction executes a signed or unsigned transaction on the node without
+// committing it and returns the predicted result, receipts and smart contract outputs.
+// When the transaction is expected to fail the simulation is returned together with
+// a *SimulationFailedError, which matches the result code errors, e.g. errors.Is(err, ErrTXVMUserError).
+// Transport errors and 5xx responses are returned unwrapped
+func (kc *kleverChain) SimulateTransaction(ctx context.Context, tx *proto.Transaction) (*models.SimulationResult, error) {
+	if tx == nil || tx.GetRawData() == nil {
+		return nil, fmt.Errorf("transaction without raw data")
+	}
+
+	body, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		Data struct {
+			Result *models.SimulationResult `json:"result"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+
+	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/simulate", kc.nodeUri(ctx)), string(body), nil, &result, options.NewIdempotent(true))
+	if err != nil {
+		// only a node rejecting the transaction predicts its failure, connection errors,
+		// rate limits and 5xx responses say nothing about it
+		var httpErr *utils.HTTPError
+		if !errors.As(err, &httpErr) || httpErr.StatusCode < 400 || httpErr.StatusCode >= 500 ||
+			httpErr.StatusCode == http.StatusTooManyRequests {
+			return nil, err
+		}
+
+		simulationErr := &SimulationFailedError{
+			ResultCode:    proto.Transaction_Fail,
+			RawResultCode: httpErr.Code,
+			Message:       err.Error(),
+			Err:           err,
+		}
+
+		return nil, simulationErr
+	}
+
+	if len(result.Error) != 0 {
+		return nil, &SimulationFailedError{
+			ResultCode:    proto.Transaction_Fail,
+			RawResultCode: result.Code,
+			Message:       result.Error,
+		}
+	}
+
+	simulation := result.Data.Result
+	if simulation == nil {
+		return nil, fmt.Errorf("node returned no simulation result")
+	}
+
+	if simulation.Result() != proto.Transaction_SUCCESS || simulation.Code() != proto.Transaction_Ok {
+		return simulation, newSimulationFailedError(simulation)
+	}
+
+	return simulation, nil
+}
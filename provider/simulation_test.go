@@ -0,0 +1,155 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/core"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+func newSimulationTestKleverChain(t *testing.T, status int, response string) provider.KleverChain {
+	return newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/transaction/simulate", r.URL.Path)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	})
+}
+
+func newSimulationTestTransaction() *proto.Transaction {
+	return &proto.Transaction{RawData: &proto.Transaction_Raw{Nonce: 1, Data: [][]byte{[]byte("draw")}}}
+}
+
+func Test_SimulateTransaction(t *testing.T) {
+	kc := newSimulationTestKleverChain(t, http.StatusOK, `{"data": {"result": {
+		"status": "success",
+		"resultCode": "Ok",
+		"kAppFee": 1000000,
+		"bandwidthFee": 2000000,
+		"gasUsed": 1500,
+		"receipts": [{"type": 4, "typeString": "SmartContract"}],
+		"logs": {"address": "`+queryTestScAddress+`", "events": [{"identifier": "draw", "topics": ["ZHJhdw=="]}]},
+		"returnData": ["AQ=="]
+	}}, "code": "successful"}`)
+
+	simulation, err := kc.SimulateTransaction(context.Background(), newSimulationTestTransaction())
+	require.Nil(t, err)
+
+	assert.Equal(t, proto.Transaction_SUCCESS, simulation.Result())
+	assert.Equal(t, proto.Transaction_Ok, simulation.Code())
+	assert.Equal(t, uint64(1500), simulation.GasUsed)
+	assert.Len(t, simulation.Receipts, 1)
+
+	hexData, err := simulation.HexReturnData()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"01"}, hexData)
+
+	tx := simulation.Transaction()
+	require.NotNil(t, tx.Logs)
+	assert.Equal(t, "draw", tx.Logs.Events[0].Identifier)
+}
+
+func Test_SimulateTransaction_Execution_Failure(t *testing.T) {
+	kc := newSimulationTestKleverChain(t, http.StatusOK, `{"data": {"result": {
+		"status": "fail",
+		"resultCode": "VMUserError",
+		"returnMessage": "lottery not running"
+	}}, "code": "successful"}`)
+
+	simulation, err := kc.SimulateTransaction(context.Background(), newSimulationTestTransaction())
+	require.NotNil(t, err)
+	require.NotNil(t, simulation)
+
+	assert.Equal(t, proto.Transaction_FAILED, simulation.Result())
+	assert.True(t, errors.Is(err, provider.ErrTXVMUserError))
+	assert.False(t, errors.Is(err, provider.ErrTXVMOutOfGas))
+
+	var simulationErr *provider.SimulationFailedError
+	require.True(t, errors.As(err, &simulationErr))
+	assert.Equal(t, proto.Transaction_VMUserError, simulationErr.ResultCode)
+	assert.Equal(t, "lottery not running", simulationErr.Message)
+	assert.Equal(t, simulation, simulationErr.Simulation)
+}
+
+func Test_SimulateTransaction_Refused(t *testing.T) {
+	kc := newSimulationTestKleverChain(t, http.StatusOK, `{"data": {}, "error": "nonce too low", "code": "internal_issue"}`)
+
+	simulation, err := kc.SimulateTransaction(context.Background(), newSimulationTestTransaction())
+	require.NotNil(t, err)
+	assert.Nil(t, simulation)
+	assert.True(t, errors.Is(err, utils.ErrNonceTooLow))
+	assert.True(t, errors.Is(err, provider.ErrTXFail))
+
+	kc = newSimulationTestKleverChain(t, http.StatusBadRequest, `{"data": {}, "error": "insufficient funds", "code": "bad_request"}`)
+
+	_, err = kc.SimulateTransaction(context.Background(), newSimulationTestTransaction())
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, utils.ErrInsufficientFunds))
+
+	var simulationErr *provider.SimulationFailedError
+	require.True(t, errors.As(err, &simulationErr))
+	assert.Equal(t, "bad_request", simulationErr.RawResultCode)
+	assert.Nil(t, simulationErr.Simulation)
+
+	_, err = kc.SimulateTransaction(context.Background(), &proto.Transaction{})
+	assert.NotNil(t, err)
+}
+
+func Test_SimulateTransaction_Unavailable(t *testing.T) {
+	kc := newSimulationTestKleverChain(t, http.StatusServiceUnavailable, `{"data": {}, "error": "node is syncing", "code": "internal_issue"}`)
+
+	_, err := kc.SimulateTransaction(context.Background(), newSimulationTestTransaction())
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, utils.ErrServerError))
+	assert.False(t, errors.Is(err, provider.ErrTXFail))
+
+	var simulationErr *provider.SimulationFailedError
+	assert.False(t, errors.As(err, &simulationErr))
+}
+
+func Test_SimulateTransaction_ConnectionError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	kc, err := provider.NewKleverChain(
+		network.NewNetworkConfigCustom(server.URL, server.URL, server.URL),
+		utils.NewHttpClient(time.Second),
+	)
+	require.Nil(t, err)
+
+	_, err = kc.SimulateTransaction(context.Background(), newSimulationTestTransaction())
+	require.NotNil(t, err)
+	assert.False(t, errors.Is(err, provider.ErrTXFail))
+
+	var requestErr *utils.RequestError
+	assert.True(t, errors.As(err, &requestErr))
+}
+
+// Test_SimulateTransaction_Recorded replays the simulation of a transfer recorded from a node
+func Test_SimulateTransaction_Recorded(t *testing.T) {
+	kc := newRecordedKleverChain(t, "simulate")
+
+	acc, err := kc.GetAccount(offlineSender)
+	require.Nil(t, err)
+
+	tx, err := kc.Send(&models.BaseTX{FromAddress: offlineSender, Nonce: acc.Nonce}, offlineReceiver, 1, core.KLV)
+	require.Nil(t, err)
+
+	result, err := kc.SimulateTransaction(context.Background(), tx)
+	require.Nil(t, err)
+	assert.Equal(t, proto.Transaction_SUCCESS, result.Result())
+	assert.True(t, result.KAppFee > 0)
+}
@@ -1,9 +1,7 @@
 package provider
 
 import (
-	"encoding/hex"
 	"fmt"
-	"os"
 
 	"github.com/klever-io/klever-go-sdk/core/address"
 	"github.com/klever-io/klever-go-sdk/models"
@@ -11,7 +9,8 @@ import (
 	"github.com/klever-io/klever-go-sdk/provider/utils"
 )
 
-type scMetadata struct {
+// SCMetadata are the flags set on deploy
+type SCMetadata struct {
 	Payable     bool
 	PayableBySC bool
 	Upgradeable bool
@@ -19,7 +18,7 @@ type scMetadata struct {
 }
 
 // ToBytes converts the metadata to bytes
-func (metadata *scMetadata) ToBytes() []byte {
+func (metadata *SCMetadata) ToBytes() []byte {
 	bytes := make([]byte, utils.LengthOfCodeMetadata)
 
 	if metadata.Upgradeable {
@@ -38,6 +37,20 @@ func (metadata *scMetadata) ToBytes() []byte {
 	return bytes
 }
 
+// SCMetadataFromBytes parses the metadata flags written by ToBytes
+func SCMetadataFromBytes(bytes []byte) (*SCMetadata, error) {
+	if len(bytes) != utils.LengthOfCodeMetadata {
+		return nil, fmt.Errorf("invalid metadata length %d", len(bytes))
+	}
+
+	return &SCMetadata{
+		Upgradeable: bytes[0]&utils.MetadataUpgradeable != 0,
+		Readable:    bytes[0]&utils.MetadataReadable != 0,
+		Payable:     bytes[1]&utils.MetadataPayable != 0,
+		PayableBySC: bytes[1]&utils.MetadataPayableBySC != 0,
+	}, nil
+}
+
 // `payable`, `payableBySC`, `upgradeable`, `readable` are the metadata to the smart contract.
 // `wasm` is a string with the path to your compiled wasm
 // Pass an empty string to `vmType`, only pass a customized value if you are very
@@ -52,38 +65,17 @@ func (kc *kleverChain) DeploySmartContract(
 	vmType string,
 	arguments ...string,
 ) (*proto.Transaction, error) {
-	if wasmPath == "" {
-		return nil, fmt.Errorf("invalid file path provided: %s", wasmPath)
-	}
-
-	wasm, err := os.ReadFile(wasmPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
-	}
-
-	metadata := scMetadata{
-		Payable:     payable,
-		PayableBySC: payableBySC,
-		Upgradeable: upgradeable,
-		Readable:    readable,
-	}
-
-	metadataHex := fmt.Sprintf("%04X", metadata.ToBytes())
-
-	if len(vmType) == 0 {
-		vmType = utils.DefaultVMType
-	}
-
-	message := []string{fmt.Sprintf("%s@%s@%s", hex.EncodeToString(wasm), vmType, metadataHex)}
-
-	base.Message = append(base.Message, message...)
-
-	return kc.HandleSmartContracts(
+	return kc.DeploySmartContractWithOptions(
 		base,
-		int32(utils.SmartContractDeploy),
-		"",
-		map[string]int64{},
-		arguments...,
+		WithCodePath(wasmPath),
+		WithMetadata(SCMetadata{
+			Payable:     payable,
+			PayableBySC: payableBySC,
+			Upgradeable: upgradeable,
+			Readable:    readable,
+		}),
+		WithVMType(vmType),
+		WithArguments(arguments...),
 	)
 }
 
@@ -109,10 +101,8 @@ func (kc *kleverChain) InvokeSmartContract(
 		return nil, err
 	}
 
-	base.Message = append(base.Message, functionToCall)
-
 	return kc.HandleSmartContracts(
-		base,
+		withSCData(base, functionToCall),
 		int32(utils.SmartContractInvoke),
 		scAddress,
 		callValue,
@@ -120,6 +110,18 @@ func (kc *kleverChain) InvokeSmartContract(
 	)
 }
 
+// withSCData returns a copy of base with `data` as the first message, the contract
+// arguments are appended to it and the caller's messages follow
+func withSCData(base *models.BaseTX, data string) *models.BaseTX {
+	scBase := *base
+	scBase.Message = append([]string{data}, base.Message...)
+
+	return &scBase
+}
+
+// HandleSmartContracts builds a smart contract transaction, the first message of
+// `base` must hold the function to call or the code to deploy, the encoded
+// `arguments` are appended to it
 func (kc *kleverChain) HandleSmartContracts(
 	base *models.BaseTX,
 	scType int32,
@@ -136,17 +138,23 @@ func (kc *kleverChain) HandleSmartContracts(
 		contract.Address = scAddress
 	}
 
+	if len(base.Message) == 0 {
+		return nil, fmt.Errorf("smart contract transaction without function or code")
+	}
+
 	argsParsed, err := EncodeInput(arguments)
 
 	if err != nil {
 		return nil, err
 	}
 
-	base.Message[0] += argsParsed
+	// the caller's messages are left untouched
+	scBase := *base
+	scBase.Message = append([]string{base.Message[0] + argsParsed}, base.Message[1:]...)
 
 	data, err := kc.buildRequest(
 		proto.TXContract_SmartContractType,
-		base,
+		&scBase,
 		[]interface{}{contract},
 	)
 
@@ -27,6 +27,29 @@ func (kc *kleverChain) Freeze(base *models.BaseTX, amount float64, kda string) (
 	return kc.PrepareTransaction(data)
 }
 
+func (kc *kleverChain) FreezeExact(base *models.BaseTX, amount models.Amount, kda string) (*proto.Transaction, error) {
+	precision, err := kc.getPrecision(kda)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAmount, err := amount.ToUnits(precision)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := []interface{}{models.FreezeTXRequest{
+		Amount: parsedAmount,
+		KDA:    kda,
+	}}
+
+	data, err := kc.buildRequest(proto.TXContract_FreezeContractType, base, contracts)
+	if err != nil {
+		return nil, err
+	}
+	return kc.PrepareTransaction(data)
+}
+
 func (kc *kleverChain) Unfreeze(base *models.BaseTX, bucketId, kda string) (*proto.Transaction, error) {
 	contracts := []interface{}{models.UnfreezeTXRequest{
 		BucketID: bucketId,
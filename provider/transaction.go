@@ -11,6 +11,10 @@ import (
 	"github.com/klever-io/klever-go-sdk/core"
 	"github.com/klever-io/klever-go-sdk/models"
 	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/tools/hasher"
+	"github.com/klever-io/klever-go-sdk/provider/tools/marshal"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options/options"
 )
 
 func (kc *kleverChain) DecodeWithContext(ctx context.Context, tx *proto.Transaction) (*models.TransactionAPI, error) {
@@ -27,7 +31,7 @@ func (kc *kleverChain) DecodeWithContext(ctx context.Context, tx *proto.Transact
 		return result.Data.Transaction, nil
 	}
 
-	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/decode", kc.networkConfig.GetNodeUri()), string(body), nil, &result)
+	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/decode", kc.nodeUri(ctx)), string(body), nil, &result, options.NewIdempotent(true))
 
 	return result.Data.Transaction, err
 }
@@ -61,6 +65,12 @@ func (kc *kleverChain) Send(base *models.BaseTX, toAddr string, amount float64,
 	return kc.MultiTransfer(base, values)
 }
 
+func (kc *kleverChain) SendExact(base *models.BaseTX, toAddr string, amount models.Amount, kda string) (*proto.Transaction, error) {
+	values := []models.ToExactAmount{{ToAddress: toAddr, Amount: amount, KDA: kda}}
+
+	return kc.MultiTransferExact(base, values)
+}
+
 func (kc *kleverChain) MultiSend(base *models.BaseTX, contracts []models.AnyContractRequest) (*proto.Transaction, error) {
 	var contractsParsed []interface{}
 
@@ -108,6 +118,33 @@ func (kc *kleverChain) MultiTransfer(base *models.BaseTX, values []models.ToAmou
 	return kc.PrepareTransaction(data)
 }
 
+func (kc *kleverChain) MultiTransferExact(base *models.BaseTX, values []models.ToExactAmount) (*proto.Transaction, error) {
+	contracts := make([]interface{}, 0)
+	for _, to := range values {
+		precision, err := kc.getPrecision(to.KDA)
+		if err != nil {
+			return nil, err
+		}
+
+		parsedAmount, err := to.Amount.ToUnits(precision)
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, models.TransferTXRequest{
+			Receiver: to.ToAddress,
+			Amount:   parsedAmount,
+			KDA:      to.KDA,
+		})
+	}
+
+	data, err := kc.buildRequest(proto.TXContract_TransferContractType, base, contracts)
+	if err != nil {
+		return nil, err
+	}
+	return kc.PrepareTransaction(data)
+}
+
 func (kc *kleverChain) buildRequest(
 	txType proto.TXContract_ContractType,
 	base *models.BaseTX,
@@ -136,6 +173,9 @@ func (kc *kleverChain) buildRequest(
 		Contract:  contract,
 		Contracts: contracts,
 		KDAFee:    base.KdaFee,
+
+		GasLimit:      base.GasLimit,
+		GasMultiplier: base.GasMultiplier,
 	}, nil
 }
 
@@ -157,7 +197,7 @@ func (kc *kleverChain) PrepareTransactionWithContext(ctx context.Context, reques
 		return nil, err
 	}
 
-	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/send", kc.networkConfig.GetNodeUri()), string(body), nil, &result)
+	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/send", kc.nodeUri(ctx)), string(body), nil, &result, options.NewIdempotent(true))
 	if err == nil {
 		hash, err := kc.CalculateHash(result.Data.Transaction.RawData)
 		if err == nil {
@@ -172,13 +212,22 @@ func (kc *kleverChain) PrepareTransactionWithContext(ctx context.Context, reques
 func (kc *kleverChain) CalculateHash(
 	object interface{},
 ) ([]byte, error) {
+	return CalculateHash(kc.hasher, kc.marshalizer, object)
+}
+
+// CalculateHash marshalizes the interface and calculates its hash with the given hasher
+func CalculateHash(
+	hasher hasher.Hasher,
+	marshalizer marshal.Marshalizer,
+	object interface{},
+) ([]byte, error) {
 
-	mrsData, err := kc.marshalizer.Marshal(object)
+	mrsData, err := marshalizer.Marshal(object)
 	if err != nil {
 		return nil, err
 	}
 
-	hash := kc.hasher.Compute(string(mrsData))
+	hash := hasher.Compute(string(mrsData))
 	return hash, nil
 }
 
@@ -191,7 +240,7 @@ func (kc *kleverChain) GetTransactionWithContext(ctx context.Context, hash strin
 
 	result.Data.Transaction = &models.TransactionAPI{}
 
-	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/transaction/%s", kc.networkConfig.GetAPIUri(), hash), &result)
+	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/transaction/%s", kc.apiUri(ctx), hash), &result)
 
 	return result.Data.Transaction, err
 }
@@ -200,6 +249,23 @@ func (kc *kleverChain) GetTransaction(hash string) (*models.TransactionAPI, erro
 	return kc.GetTransactionWithContext(context.Background(), hash)
 }
 
+type broadcastRetryKey struct{}
+
+// WithBroadcastRetry allows the http client retry policy to re-send broadcasts made with the
+// returned context. A broadcast that timed out may already be in the mempool, only opt in
+// when the node rejecting the duplicated transaction is acceptable.
+func WithBroadcastRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, broadcastRetryKey{}, true)
+}
+
+func broadcastOptions(ctx context.Context) []http_options.IOptions {
+	if retry, _ := ctx.Value(broadcastRetryKey{}).(bool); retry {
+		return []http_options.IOptions{options.NewIdempotent(true)}
+	}
+
+	return nil
+}
+
 func (kc *kleverChain) BroadcastTransactionWithContext(ctx context.Context, tx *proto.Transaction) (string, error) {
 	toBroadcast := struct {
 		TX *proto.Transaction `json:"tx"`
@@ -221,13 +287,14 @@ func (kc *kleverChain) BroadcastTransactionWithContext(ctx context.Context, tx *
 		Code  string `json:"code"`
 	}{}
 
-	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/broadcast", kc.networkConfig.GetNodeUri()), string(data), nil, &result)
+	url := fmt.Sprintf("%s/transaction/broadcast", kc.nodeUri(ctx))
+	err = kc.httpClient.Post(ctx, url, string(data), nil, &result, broadcastOptions(ctx)...)
 	if err != nil {
-		return "", err
+		return "", newBroadcastError(url, err)
 	}
 
 	if len(result.Error) != 0 {
-		return "", fmt.Errorf("error broadcasting transcation: %s", result.Error)
+		return "", &BroadcastError{URL: url, Code: result.Code, Message: result.Error}
 	}
 
 	return result.Data.TXHash, err
@@ -261,13 +328,14 @@ func (kc *kleverChain) BroadcastTransactionsWithContext(ctx context.Context, txs
 		Code  string `json:"code"`
 	}{}
 
-	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/transaction/broadcast", kc.networkConfig.GetNodeUri()), string(data), nil, &result)
+	url := fmt.Sprintf("%s/transaction/broadcast", kc.nodeUri(ctx))
+	err = kc.httpClient.Post(ctx, url, string(data), nil, &result, broadcastOptions(ctx)...)
 	if err != nil {
-		return nil, err
+		return nil, newBroadcastError(url, err)
 	}
 
 	if len(result.Error) != 0 {
-		return nil, fmt.Errorf("error broadcasting transcations: %s", result.Error)
+		return nil, &BroadcastError{URL: url, Code: result.Code, Message: result.Error}
 	}
 
 	return result.Data.TxsHashes, nil
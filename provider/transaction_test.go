@@ -0,0 +1,46 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+func Test_Broadcast_RetryIsOptIn(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"txCount":1,"txHash":"abc"}}`)
+	}))
+	defer server.Close()
+
+	kc, err := provider.NewKleverChain(
+		network.NewNetworkConfigCustom(server.URL, server.URL, server.URL),
+		utils.NewHttpClient(time.Second, utils.WithRetryPolicy(utils.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})),
+	)
+	require.Nil(t, err)
+
+	_, err = kc.BroadcastTransactionWithContext(context.Background(), &proto.Transaction{})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	hash, err := kc.BroadcastTransactionWithContext(provider.WithBroadcastRetry(context.Background()), &proto.Transaction{})
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", hash)
+	assert.Equal(t, int32(3), calls.Load())
+}
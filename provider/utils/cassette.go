@@ -0,0 +1,310 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+const redactedValue = "[REDACTED]"
+
+// DefaultRedactedHeaders are the headers never written to cassette files
+var DefaultRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+// DefaultRedactedQueryParams are the query parameters, matched ignoring case, never written to cassette files
+var DefaultRedactedQueryParams = []string{
+	"apikey",
+	"api_key",
+	"key",
+	"token",
+	"access_token",
+	"secret",
+	"password",
+}
+
+type CassetteMode int
+
+const (
+	// CassetteReplay serves responses from the cassette file and never reaches the network
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord forwards requests to the transport and keeps the interactions for Save
+	CassetteRecord
+)
+
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// Cassette is an http.RoundTripper that records request/response pairs to a file
+// and replays them in the recorded order, each request must match the method, path,
+// query and normalized JSON body of the next interaction.
+// Use it with NewHttpClient(timeout, WithTransport(cassette)).
+type Cassette struct {
+	path          string
+	mode          CassetteMode
+	transport     http.RoundTripper
+	redacted      []string
+	redactedQuery []string
+
+	mut          sync.Mutex
+	interactions []CassetteInteraction
+	next         int
+}
+
+// NewCassetteRecorder records interactions made through transport, http.DefaultTransport if nil,
+// call Save to write them to path
+func NewCassetteRecorder(path string, transport http.RoundTripper) *Cassette {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Cassette{
+		path:          path,
+		mode:          CassetteRecord,
+		transport:     transport,
+		redacted:      DefaultRedactedHeaders,
+		redactedQuery: DefaultRedactedQueryParams,
+	}
+}
+
+// NewCassettePlayer loads the interactions recorded in path
+func NewCassettePlayer(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cassette{path: path, mode: CassetteReplay, redacted: DefaultRedactedHeaders, redactedQuery: DefaultRedactedQueryParams}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// RedactHeaders replaces the default list of headers redacted when recording
+func (c *Cassette) RedactHeaders(headers ...string) {
+	c.redacted = headers
+}
+
+// RedactQueryParams replaces the default list of query parameters redacted when recording,
+// requests are redacted the same way before being matched on replay
+func (c *Cassette) RedactQueryParams(params ...string) {
+	c.redactedQuery = params
+}
+
+// Remaining returns the number of interactions not replayed yet
+func (c *Cassette) Remaining() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return len(c.interactions) - c.next
+}
+
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.mode == CassetteReplay {
+		return c.replay(req, body)
+	}
+
+	return c.record(req, body)
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func (c *Cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	path := requestPath(c.redactURL(req.URL.String()))
+
+	if c.next >= len(c.interactions) {
+		return nil, fmt.Errorf("cassette %s has no interaction left for %s %s", c.path, req.Method, path)
+	}
+
+	interaction := c.interactions[c.next]
+	expectedPath := requestPath(c.redactURL(interaction.Request.URL))
+	if interaction.Request.Method != req.Method || expectedPath != path {
+		return nil, fmt.Errorf("cassette %s expected %s %s as interaction %d, got %s %s",
+			c.path, interaction.Request.Method, expectedPath, c.next, req.Method, path)
+	}
+
+	if normalizeBody([]byte(interaction.Request.Body)) != normalizeBody(body) {
+		return nil, fmt.Errorf("cassette %s expected another body for %s %s as interaction %d", c.path, req.Method, path, c.next)
+	}
+
+	c.next++
+
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (c *Cassette) record(req *http.Request, body []byte) (*http.Response, error) {
+	r, err := c.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.interactions = append(c.interactions, CassetteInteraction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			URL:    c.redactURL(req.URL.String()),
+			Header: c.redact(req.Header),
+			Body:   string(body),
+		},
+		Response: CassetteResponse{
+			StatusCode: r.StatusCode,
+			Header:     c.redact(r.Header),
+			Body:       string(respBody),
+		},
+	})
+	return r, nil
+}
+
+func (c *Cassette) redact(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range c.redacted {
+		if len(redacted.Values(name)) != 0 {
+			redacted.Set(name, redactedValue)
+		}
+	}
+
+	return redacted
+}
+
+func (c *Cassette) redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || len(u.RawQuery) == 0 {
+		return raw
+	}
+
+	query := u.Query()
+	redacted := false
+	for key, values := range query {
+		for _, name := range c.redactedQuery {
+			if strings.EqualFold(key, name) {
+				for i := range values {
+					values[i] = redactedValue
+				}
+				redacted = true
+			}
+		}
+	}
+
+	if !redacted {
+		return raw
+	}
+
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// Save writes the recorded interactions to the cassette file
+func (c *Cassette) Save() error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(data, '\n'), 0644)
+}
+
+// requestPath drops scheme and host, so cassettes replay against any endpoint
+func requestPath(url string) string {
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+		if j := strings.Index(url, "/"); j >= 0 {
+			return url[j:]
+		}
+		return "/"
+	}
+
+	return url
+}
+
+func normalizeBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if normalized, err := json.Marshal(v); err == nil {
+			return string(normalized)
+		}
+	}
+
+	return strings.TrimSpace(string(body))
+}
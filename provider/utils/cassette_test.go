@@ -0,0 +1,122 @@
+package utils_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":"%s %s"}`, r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder := utils.NewCassetteRecorder(path, nil)
+	client := utils.NewHttpClient(time.Second, utils.WithTransport(recorder))
+
+	target := struct {
+		Data string `json:"data"`
+	}{}
+	err := client.Post(context.Background(), server.URL+"/transaction/send", `{"b": 2, "a": 1}`, []string{"Authorization", "Bearer secret"}, &target)
+	require.Nil(t, err)
+	require.Nil(t, recorder.Save())
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), "secret")
+	assert.Contains(t, string(data), "[REDACTED]")
+
+	server.Close()
+
+	player, err := utils.NewCassettePlayer(path)
+	require.Nil(t, err)
+	client = utils.NewHttpClient(time.Second, utils.WithTransport(player))
+
+	// another host and key order still match
+	err = client.Post(context.Background(), "http://other-host/transaction/send", `{"a":1,"b":2}`, nil, &target)
+	require.Nil(t, err)
+	assert.Equal(t, "POST /transaction/send", target.Data)
+
+	err = client.Post(context.Background(), "http://other-host/transaction/send", `{"a":1,"b":3}`, nil, &target)
+	assert.Contains(t, err.Error(), "has no interaction left for POST /transaction/send")
+}
+
+func TestCassette_ReplayInOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":"%s %s"}`, r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder := utils.NewCassetteRecorder(path, nil)
+	client := utils.NewHttpClient(time.Second, utils.WithTransport(recorder))
+
+	target := struct {
+		Data string `json:"data"`
+	}{}
+	require.Nil(t, client.Get(context.Background(), server.URL+"/address/first", &target))
+	require.Nil(t, client.Get(context.Background(), server.URL+"/address/second", &target))
+	require.Nil(t, client.Post(context.Background(), server.URL+"/transaction/send", `{"a":1}`, nil, &target))
+	require.Nil(t, recorder.Save())
+
+	player, err := utils.NewCassettePlayer(path)
+	require.Nil(t, err)
+	client = utils.NewHttpClient(time.Second, utils.WithTransport(player))
+
+	err = client.Get(context.Background(), server.URL+"/address/second", &target)
+	assert.Contains(t, err.Error(), "expected GET /address/first as interaction 0, got GET /address/second")
+
+	require.Nil(t, client.Get(context.Background(), server.URL+"/address/first", &target))
+	assert.Equal(t, "GET /address/first", target.Data)
+	assert.Equal(t, 2, player.Remaining())
+
+	require.Nil(t, client.Get(context.Background(), server.URL+"/address/second", &target))
+
+	err = client.Post(context.Background(), server.URL+"/transaction/send", `{"a":2}`, nil, &target)
+	assert.Contains(t, err.Error(), "expected another body for POST /transaction/send")
+	assert.Equal(t, 1, player.Remaining())
+}
+
+func TestCassette_RedactQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":"%s"}`, r.URL.Query().Get("limit"))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder := utils.NewCassetteRecorder(path, nil)
+	client := utils.NewHttpClient(time.Second, utils.WithTransport(recorder))
+
+	target := struct {
+		Data string `json:"data"`
+	}{}
+	require.Nil(t, client.Get(context.Background(), server.URL+"/transaction/list?limit=5&API_KEY=secret-key", &target))
+	require.Nil(t, recorder.Save())
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), "secret-key")
+	assert.Contains(t, string(data), "limit=5")
+
+	player, err := utils.NewCassettePlayer(path)
+	require.Nil(t, err)
+	client = utils.NewHttpClient(time.Second, utils.WithTransport(player))
+
+	// any key replays the redacted request, other parameters must match
+	err = client.Get(context.Background(), server.URL+"/transaction/list?limit=6&API_KEY=other-key", &target)
+	assert.NotNil(t, err)
+
+	require.Nil(t, client.Get(context.Background(), server.URL+"/transaction/list?limit=5&API_KEY=other-key", &target))
+	assert.Equal(t, "5", target.Data)
+}
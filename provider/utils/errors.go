@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrBadRequest        = errors.New("bad request")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrServerError       = errors.New("server error")
+	ErrTimeout           = errors.New("timeout")
+	ErrNonceTooLow       = errors.New("nonce too low")
+	ErrNonceTooHigh      = errors.New("nonce too high")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrAlreadyExists     = errors.New("already exists")
+)
+
+// HTTPError is returned when the node or API answers with an error,
+// it matches the sentinel errors of this package with errors.Is
+type HTTPError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Code is the `code` field of the node response, e.g. internal_issue
+	Code string
+	// Message is the `error` field of the node response
+	Message string
+	Body    []byte
+	Header  http.Header
+}
+
+func newHTTPError(req *http.Request, r *http.Response, body []byte) *HTTPError {
+	httpErr := &HTTPError{
+		StatusCode: r.StatusCode,
+		Body:       body,
+		Header:     r.Header,
+	}
+
+	if req != nil {
+		httpErr.Method = req.Method
+		httpErr.URL = req.URL.String()
+	}
+
+	// check if error marshal
+	var iErr map[string]interface{}
+	if err := json.Unmarshal(body, &iErr); err == nil {
+		if v, ok := iErr["error"].(string); ok {
+			httpErr.Message = v
+		}
+		if v, ok := iErr["code"].(string); ok {
+			httpErr.Code = v
+		}
+	} else {
+		httpErr.Message = string(body)
+	}
+
+	return httpErr
+}
+
+// Error always holds the request and the status code, followed by the node message if any,
+// e.g. "POST https://node.testnet.klever.org/transaction/broadcast: status 400: lower nonce in transaction"
+func (e *HTTPError) Error() string {
+	request := fmt.Sprintf("status %d", e.StatusCode)
+	if len(e.Method) != 0 || len(e.URL) != 0 {
+		request = fmt.Sprintf("%s %s: %s", e.Method, e.URL, request)
+	}
+
+	if len(e.Message) != 0 {
+		return fmt.Sprintf("%s: %s", request, e.Message)
+	}
+
+	return request
+}
+
+func (e *HTTPError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		if target == ErrNotFound {
+			return true
+		}
+	case http.StatusBadRequest:
+		if target == ErrBadRequest {
+			return true
+		}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		if target == ErrUnauthorized {
+			return true
+		}
+	case http.StatusTooManyRequests:
+		if target == ErrRateLimited {
+			return true
+		}
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		if target == ErrTimeout {
+			return true
+		}
+	}
+
+	if e.StatusCode >= 500 && target == ErrServerError {
+		return true
+	}
+
+	return MatchNodeMessage(e.Message, target)
+}
+
+// MatchNodeMessage reports whether a node error message corresponds to the sentinel error
+func MatchNodeMessage(message string, target error) bool {
+	message = strings.ToLower(message)
+	if len(message) == 0 {
+		return false
+	}
+
+	contains := func(substrs ...string) bool {
+		for _, s := range substrs {
+			if strings.Contains(message, s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch target {
+	case ErrNotFound:
+		return contains("not found")
+	case ErrNonceTooLow:
+		return contains("lower nonce", "nonce too low")
+	case ErrNonceTooHigh:
+		return contains("higher nonce", "nonce too high")
+	case ErrInsufficientFunds:
+		return contains("insufficient", "out of funds", "not enough balance")
+	case ErrInvalidSignature:
+		// only the signature check of the transaction, VM errors such as
+		// "wrong signature" of a function call must not match
+		return contains("invalid signature", "signature is invalid", "signature verification failed")
+	case ErrAlreadyExists:
+		return contains("already exists", "already in pool", "duplicated")
+	}
+
+	return false
+}
+
+// RequestError is returned when the request could not be completed,
+// e.g. connection refused or timeout
+type RequestError struct {
+	Method string
+	URL    string
+	Err    error
+}
+
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+func (e *RequestError) Is(target error) bool {
+	if target != ErrTimeout {
+		return false
+	}
+
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(e.Err, &netErr) && netErr.Timeout()
+}
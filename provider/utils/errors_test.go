@@ -0,0 +1,93 @@
+package utils_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpClient_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"data":null,"error":"lower nonce in transaction","code":"bad_request"}`)
+	}))
+	defer server.Close()
+
+	client := utils.NewHttpClient(time.Second)
+	err := client.Post(context.Background(), server.URL+"/transaction/broadcast", "{}", nil, &struct{}{})
+	require.NotNil(t, err)
+
+	var httpErr *utils.HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+	assert.Equal(t, "bad_request", httpErr.Code)
+	assert.Equal(t, server.URL+"/transaction/broadcast", httpErr.URL)
+	assert.Equal(t, "lower nonce in transaction", httpErr.Message)
+	assert.Equal(t, "POST "+server.URL+"/transaction/broadcast: status 400: lower nonce in transaction", err.Error())
+
+	assert.True(t, errors.Is(err, utils.ErrBadRequest))
+	assert.True(t, errors.Is(err, utils.ErrNonceTooLow))
+	assert.False(t, errors.Is(err, utils.ErrInsufficientFunds))
+	assert.False(t, errors.Is(err, utils.ErrNotFound))
+}
+
+func TestHttpClient_NotFoundAndServerError(t *testing.T) {
+	status := http.StatusNotFound
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, `plain text`)
+	}))
+	defer server.Close()
+
+	client := utils.NewHttpClient(time.Second)
+	err := client.Get(context.Background(), server.URL, &struct{}{})
+	assert.True(t, errors.Is(err, utils.ErrNotFound))
+	assert.Equal(t, "GET "+server.URL+": status 404: plain text", err.Error())
+
+	status = http.StatusBadGateway
+	err = client.Get(context.Background(), server.URL, &struct{}{})
+	assert.True(t, errors.Is(err, utils.ErrServerError))
+}
+
+func TestHttpClient_RequestError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := utils.NewHttpClient(10 * time.Millisecond)
+	err := client.Get(context.Background(), server.URL, &struct{}{})
+
+	var requestErr *utils.RequestError
+	require.True(t, errors.As(err, &requestErr))
+	assert.Equal(t, http.MethodGet, requestErr.Method)
+	assert.True(t, errors.Is(err, utils.ErrTimeout))
+}
+
+func TestMatchNodeMessage(t *testing.T) {
+	testCases := []struct {
+		message string
+		target  error
+		matches bool
+	}{
+		{"invalid signature", utils.ErrInvalidSignature, true},
+		{"transaction signature verification failed", utils.ErrInvalidSignature, true},
+		{"VM function wrong signature", utils.ErrInvalidSignature, false},
+		{"missing signature", utils.ErrInvalidSignature, false},
+		{"lower nonce in transaction", utils.ErrNonceTooLow, true},
+		{"transaction already in pool", utils.ErrAlreadyExists, true},
+		{"", utils.ErrNotFound, false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.matches, utils.MatchNodeMessage(tc.message, tc.target), tc.message)
+	}
+}
@@ -3,7 +3,6 @@ package utils
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -14,33 +13,58 @@ import (
 
 type httpClient struct {
 	http.Client
+
+	retryPolicy *RetryPolicy
 }
 
 const defaultUserAgent = "kleversdk/1.0"
 
-func NewHttpClient(timeout time.Duration) HttpClient {
-	return &httpClient{http.Client{Timeout: timeout}}
+// ClientOption customizes the client created by NewHttpClient
+type ClientOption func(*httpClient)
+
+// WithRetryPolicy retries failed idempotent calls following the policy,
+// GET calls are idempotent by default and POST calls must be marked with options.NewIdempotent
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(h *httpClient) {
+		h.retryPolicy = &policy
+	}
 }
 
-func newFromError(errMessage []byte) error {
-	message := string(errMessage)
-
-	// check if error marshal
-	var iErr map[string]interface{}
-	err := json.Unmarshal(errMessage, &iErr)
-	if err == nil {
-		v, ok := iErr["error"]
-		if ok {
-			if message, ok = v.(string); !ok {
-				message = string(errMessage)
-			}
-		}
+// WithTransport sets the http.RoundTripper used by the client, e.g. a Cassette
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(h *httpClient) {
+		h.Client.Transport = transport
+	}
+}
+
+func NewHttpClient(timeout time.Duration, opts ...ClientOption) HttpClient {
+	h := &httpClient{Client: http.Client{Timeout: timeout}}
+	for _, opt := range opts {
+		opt(h)
 	}
-	return fmt.Errorf("%s", message)
+
+	return h
 }
 
 // GetURL provides json result decode to struct
 func (h *httpClient) Get(ctx context.Context, url string, target interface{}, options ...http_options.IOptions) error {
+	idempotent := http_options.DoIdempotent(true, options...)
+
+	return h.retryPolicy.do(ctx, idempotent, func() error {
+		return h.get(ctx, url, target, options...)
+	})
+}
+
+// Post provides a post using a json string
+func (h *httpClient) Post(ctx context.Context, url string, body string, headers []string, target interface{}, options ...http_options.IOptions) error {
+	idempotent := http_options.DoIdempotent(false, options...)
+
+	return h.retryPolicy.do(ctx, idempotent, func() error {
+		return h.post(ctx, url, body, headers, target, options...)
+	})
+}
+
+func (h *httpClient) get(ctx context.Context, url string, target interface{}, options ...http_options.IOptions) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return err
@@ -49,7 +73,7 @@ func (h *httpClient) Get(ctx context.Context, url string, target interface{}, op
 	http_options.DoOptions(req, options...)
 	r, err := h.Do(req)
 	if err != nil {
-		return err
+		return &RequestError{Method: req.Method, URL: url, Err: err}
 	}
 	defer r.Body.Close()
 
@@ -63,21 +87,17 @@ func (h *httpClient) Get(ctx context.Context, url string, target interface{}, op
 
 		if marshalError {
 			// try to use model
-			if err := json.Unmarshal(body, &target); err == nil {
-				return fmt.Errorf("request failed status %d", r.StatusCode)
-			}
-			return fmt.Errorf("request failed status %d - message :%v", r.StatusCode, string(body))
+			_ = json.Unmarshal(body, &target)
 		}
 
-		return newFromError(body)
+		return newHTTPError(req, r, body)
 
 	}
 
 	return json.Unmarshal(body, target)
 }
 
-// Post provides a post using a json string
-func (h *httpClient) Post(ctx context.Context, url string, body string, headers []string, target interface{}, options ...http_options.IOptions) error {
+func (h *httpClient) post(ctx context.Context, url string, body string, headers []string, target interface{}, options ...http_options.IOptions) error {
 	reqBody := strings.NewReader(body)
 	req, errNewReq := http.NewRequestWithContext(ctx, http.MethodPost, url, reqBody)
 	if errNewReq != nil {
@@ -91,7 +111,7 @@ func (h *httpClient) Post(ctx context.Context, url string, body string, headers
 
 	r, err := h.Do(req)
 	if err != nil {
-		return err
+		return &RequestError{Method: req.Method, URL: url, Err: err}
 	}
 	defer r.Body.Close()
 
@@ -105,13 +125,10 @@ func (h *httpClient) Post(ctx context.Context, url string, body string, headers
 		marshalError := http_options.DodMarshalError(options...)
 		// try to use model
 		if marshalError {
-			if err := json.Unmarshal(data, &target); err == nil {
-				return fmt.Errorf("request failed status %d", r.StatusCode)
-			}
-			return fmt.Errorf("request failed status %d - message :%v", r.StatusCode, string(data))
+			_ = json.Unmarshal(data, &target)
 		}
 
-		return newFromError(data)
+		return newHTTPError(req, r, data)
 	}
 
 	if err := json.Unmarshal(data, &target); err != nil {
@@ -5,4 +5,5 @@ type OptionType int
 const (
 	Header OptionType = iota + 1
 	MarshalError
+	Idempotent
 )
@@ -26,3 +26,13 @@ func DodMarshalError(options ...IOptions) bool {
 	}
 	return false
 }
+
+// DoIdempotent returns if the call was marked idempotent, or defaultValue when it was not marked
+func DoIdempotent(defaultValue bool, options ...IOptions) bool {
+	for _, opt := range options {
+		if idempotent, ok := opt.(IIdempotentOptions); ok && opt.GetType() == Idempotent {
+			return idempotent.GetIdempotent()
+		}
+	}
+	return defaultValue
+}
@@ -5,3 +5,10 @@ type IOptions interface {
 	GetHeaders() [][2]string
 	GetMarshalError() bool
 }
+
+// IIdempotentOptions are the options of type Idempotent, kept apart from IOptions
+// so existing implementations of IOptions don't need to change
+type IIdempotentOptions interface {
+	IOptions
+	GetIdempotent() bool
+}
@@ -0,0 +1,24 @@
+package options
+
+import "github.com/klever-io/klever-go-sdk/provider/utils/http_options"
+
+var _ http_options.IIdempotentOptions = (*IdempotentOption)(nil)
+
+type IdempotentOption struct {
+	*http_options.HTTPOptions
+	Idempotent bool `json:"idempotent"`
+}
+
+// NewIdempotent marks if a call can be safely retried by the client retry policy
+func NewIdempotent(idempotent bool) http_options.IOptions {
+	opts := IdempotentOption{
+		HTTPOptions: &http_options.HTTPOptions{Type: http_options.Idempotent},
+		Idempotent:  idempotent,
+	}
+
+	return &opts
+}
+
+func (s *IdempotentOption) GetIdempotent() bool {
+	return s.Idempotent
+}
@@ -1,7 +1,12 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -16,3 +21,127 @@ func Retry(attempts int, sleep time.Duration, f func() error) (err error) {
 	}
 	return fmt.Errorf("after %d attempts, last error: %s", attempts, err)
 }
+
+// RetryPolicy retries failed calls with a capped exponential backoff and jitter
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubled for each following retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, including delays asked by Retry-After
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the delay randomized, between 0 and 1
+	Jitter float64
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Jitter:         0.2,
+	}
+}
+
+// do runs f until it succeeds, the error is not retryable, the attempts are over
+// or the next delay would exceed the context deadline
+func (p *RetryPolicy) do(ctx context.Context, idempotent bool, f func() error) error {
+	err := f()
+	if p == nil || !idempotent {
+		return err
+	}
+
+	for attempt := 1; attempt < p.MaxAttempts && err != nil; attempt++ {
+		if ctx.Err() != nil || !IsRetryable(err) {
+			return err
+		}
+
+		delay := p.delay(attempt, err)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		err = f()
+	}
+
+	return err
+}
+
+func (p *RetryPolicy) delay(attempt int, err error) time.Duration {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		if retryAfter, ok := parseRetryAfter(httpErr.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && retryAfter > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return retryAfter
+		}
+	}
+
+	delay := p.InitialBackoff << (attempt - 1)
+	if delay <= 0 {
+		delay = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		jitter := float64(delay) * p.Jitter
+		delay = time.Duration(float64(delay) - jitter + rand.Float64()*2*jitter)
+	}
+
+	// the jittered delay is capped too, MaxBackoff is the longest wait
+	if p.MaxBackoff > 0 && (delay < 0 || delay > p.MaxBackoff) {
+		delay = p.MaxBackoff
+	}
+
+	return delay
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// IsRetryable reports whether the error is a transport failure, a rate limit or a server error
+func IsRetryable(err error) bool {
+	var requestErr *RequestError
+	if errors.As(err, &requestErr) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,152 @@
+package utils_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFlakyServer(t *testing.T, failures int32, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			if len(retryAfter) != 0 {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"data":"ok"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func testRetryPolicy() utils.RetryPolicy {
+	return utils.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		Jitter:         0.5,
+	}
+}
+
+func TestRetryPolicy_GetIsRetried(t *testing.T) {
+	server, calls := newFlakyServer(t, 2, "1")
+	client := utils.NewHttpClient(time.Second, utils.WithRetryPolicy(testRetryPolicy()))
+
+	target := struct {
+		Data string `json:"data"`
+	}{}
+	err := client.Get(context.Background(), server.URL, &target)
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", target.Data)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryPolicy_MaxAttempts(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, "")
+	client := utils.NewHttpClient(time.Second, utils.WithRetryPolicy(testRetryPolicy()))
+
+	err := client.Get(context.Background(), server.URL, &struct{}{})
+	assert.True(t, errors.Is(err, utils.ErrServerError))
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryPolicy_PostOnlyWhenIdempotent(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, "")
+	client := utils.NewHttpClient(time.Second, utils.WithRetryPolicy(testRetryPolicy()))
+
+	err := client.Post(context.Background(), server.URL, "{}", nil, &struct{}{})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	err = client.Post(context.Background(), server.URL, "{}", nil, &struct{}{}, options.NewIdempotent(true))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryPolicy_RespectsDeadline(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, "")
+	policy := testRetryPolicy()
+	policy.InitialBackoff = time.Second
+	policy.MaxBackoff = time.Second
+	client := utils.NewHttpClient(time.Second, utils.WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Get(ctx, server.URL, &struct{}{})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestRetryPolicy_JitterIsCapped(t *testing.T) {
+	var mut sync.Mutex
+	var calls []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mut.Lock()
+		calls = append(calls, time.Now())
+		mut.Unlock()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	// the backoff is always over the cap, jitter could double it before being capped
+	policy := utils.RetryPolicy{MaxAttempts: 21, InitialBackoff: time.Hour, MaxBackoff: 20 * time.Millisecond, Jitter: 1}
+	client := utils.NewHttpClient(time.Second, utils.WithRetryPolicy(policy))
+
+	err := client.Get(context.Background(), server.URL, &struct{}{})
+	assert.NotNil(t, err)
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Len(t, calls, 21)
+	for i := 1; i < len(calls); i++ {
+		assert.Less(t, calls[i].Sub(calls[i-1]), 30*time.Millisecond, "retry %d", i)
+	}
+}
+
+// headerOption implements IOptions as written before the idempotent option existed
+type headerOption struct{}
+
+func (headerOption) GetType() http_options.OptionType { return http_options.Header }
+func (headerOption) GetHeaders() [][2]string          { return [][2]string{{"X-Test", "1"}} }
+func (headerOption) GetMarshalError() bool            { return false }
+
+func TestRetryPolicy_CustomOptions(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, "")
+	client := utils.NewHttpClient(time.Second, utils.WithRetryPolicy(testRetryPolicy()))
+
+	// options without GetIdempotent leave the call default
+	err := client.Get(context.Background(), server.URL, &struct{}{}, headerOption{})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+
+	err = client.Post(context.Background(), server.URL, "{}", nil, &struct{}{}, headerOption{})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryPolicy_WithoutPolicy(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, "")
+	client := utils.NewHttpClient(time.Second)
+
+	err := client.Get(context.Background(), server.URL, &struct{}{})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/tools/hasher"
+	"github.com/klever-io/klever-go-sdk/provider/tools/marshal"
+)
+
+// TransactionSignatures is the result of verifying a transaction against the sender permission
+type TransactionSignatures struct {
+	Hash         []byte
+	PermissionID int32
+	// Signers are the addresses that made each signature, in the signatures order
+	Signers   []string
+	Weight    int64
+	Threshold int64
+}
+
+// VerifyTransaction recomputes the transaction hash and checks every signature against the
+// signers of the permission used by the transaction, the owner permission 0 included. The permission
+// must allow its contracts and their weight must reach its threshold. `account` is the transaction
+// sender, as returned by GetAccount, accounts without permissions are signed by their own key
+func VerifyTransaction(
+	tx *proto.Transaction,
+	hasher hasher.Hasher,
+	marshalizer marshal.Marshalizer,
+	account *models.Account,
+) (*TransactionSignatures, error) {
+	hash, err := verifyTransactionHash(tx, hasher, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil || account.AccountInfo == nil {
+		return nil, fmt.Errorf("account info not loaded")
+	}
+
+	sender, err := address.NewAddressFromBytes(tx.GetRawData().GetSender())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction sender: %w", err)
+	}
+
+	if sender.Bech32() != account.Address {
+		return nil, fmt.Errorf("transaction sender is %s, got account %s", sender.Bech32(), account.Address)
+	}
+
+	permission, err := account.GetPermission(tx.GetRawData().GetPermissionID())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TransactionSignatures{
+		Hash:         hash,
+		PermissionID: permission.ID,
+		Threshold:    permission.Threshold,
+	}
+
+	used := make(map[string]struct{}, len(tx.GetSignature()))
+	for i, signature := range tx.GetSignature() {
+		signer, err := permission.FindSigner(hash, signature)
+		if err != nil {
+			return nil, fmt.Errorf("%w: signature %d: %s", ErrTXInvalidSignature, i, err.Error())
+		}
+
+		if _, exists := used[signer.Address]; exists {
+			return nil, fmt.Errorf("%w: signature %d repeats the signer %s", ErrTXInvalidSignature, i, signer.Address)
+		}
+		used[signer.Address] = struct{}{}
+
+		check, err := account.CheckPermission(permission.ID, signer.Address, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(check.Missing) != 0 {
+			return nil, fmt.Errorf("%w: permission %d doesn't allow %v", ErrTXOperationNotAllowed, permission.ID, check.Missing)
+		}
+
+		result.Signers = append(result.Signers, signer.Address)
+		result.Weight += check.Weight
+	}
+
+	if result.Weight < result.Threshold {
+		return result, fmt.Errorf("%w: weight %d of %d", ErrTXThresholdNotReached, result.Weight, result.Threshold)
+	}
+
+	return result, nil
+}
+
+// VerifyTransactionSignatures is VerifyTransaction with the sender account read from the API
+func (kc *kleverChain) VerifyTransactionSignatures(ctx context.Context, tx *proto.Transaction) (*TransactionSignatures, error) {
+	sender, err := address.NewAddressFromBytes(tx.GetRawData().GetSender())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction sender: %w", err)
+	}
+
+	account, err := kc.GetAccountWithContext(ctx, sender.Bech32())
+	if err != nil {
+		return nil, err
+	}
+
+	return VerifyTransaction(tx, kc.hasher, kc.marshalizer, account)
+}
+
+func verifyTransactionHash(tx *proto.Transaction, hasher hasher.Hasher, marshalizer marshal.Marshalizer) ([]byte, error) {
+	if tx == nil || tx.GetRawData() == nil {
+		return nil, fmt.Errorf("transaction without raw data")
+	}
+
+	hash, err := CalculateHash(hasher, marshalizer, tx.GetRawData())
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(hash, tx.Hash) {
+		return nil, ErrTXHashMismatch
+	}
+
+	if len(tx.GetSignature()) == 0 {
+		return nil, ErrTXNotSigned
+	}
+
+	return hash, nil
+}
@@ -0,0 +1,213 @@
+package provider_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/core/wallet"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/tools/hasher"
+	"github.com/klever-io/klever-go-sdk/provider/tools/marshal"
+)
+
+func newVerifyTestWallet(t *testing.T, seed byte) (wallet.Wallet, string) {
+	w, err := wallet.NewWallet(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+	require.Nil(t, err)
+
+	acc, err := w.GetAccount()
+	require.Nil(t, err)
+
+	return w, acc.Address().Bech32()
+}
+
+func newSignedTransaction(t *testing.T, sender wallet.Wallet, permID int32, signers ...wallet.Wallet) *proto.Transaction {
+	return newSignedContractTransaction(t, proto.TXContract_TransferContractType, sender, permID, signers...)
+}
+
+func newSignedContractTransaction(
+	t *testing.T,
+	contractType proto.TXContract_ContractType,
+	sender wallet.Wallet,
+	permID int32,
+	signers ...wallet.Wallet,
+) *proto.Transaction {
+	h, err := hasher.NewHasher()
+	require.Nil(t, err)
+
+	tx := &proto.Transaction{RawData: &proto.Transaction_Raw{
+		Nonce:        1,
+		Sender:       sender.PublicKey(),
+		PermissionID: permID,
+		ChainID:      []byte("100420"),
+		Contract:     []*proto.TXContract{{Type: contractType}},
+	}}
+
+	tx.Hash, err = provider.CalculateHash(h, marshal.NewProtoMarshalizer(), tx.RawData)
+	require.Nil(t, err)
+
+	for _, signer := range signers {
+		require.Nil(t, tx.Sign(signer))
+	}
+
+	return tx
+}
+
+func newVerifyTestAccount(t *testing.T, ownerAddr, firstAddr, secondAddr string) *models.Account {
+	operations, err := models.NewPermissionOperations(proto.TXContract_TransferContractType)
+	require.Nil(t, err)
+
+	return &models.Account{AccountInfo: &models.AccountInfo{
+		Address: ownerAddr,
+		Permissions: []models.Permissions{
+			{ID: 0, Type: models.PermissionTypeOwner, Threshold: 1, Signers: []models.PermissionKey{{Address: ownerAddr, Weight: 1}}},
+			{ID: 2, Type: models.PermissionTypeUser, Threshold: 2, Operations: operations.String(), Signers: []models.PermissionKey{
+				{Address: firstAddr, Weight: 1},
+				{Address: secondAddr, Weight: 1},
+			}},
+		},
+	}}
+}
+
+func Test_VerifyTransaction(t *testing.T) {
+	h, err := hasher.NewHasher()
+	require.Nil(t, err)
+	m := marshal.NewProtoMarshalizer()
+
+	owner, ownerAddr := newVerifyTestWallet(t, 1)
+	first, firstAddr := newVerifyTestWallet(t, 2)
+	second, secondAddr := newVerifyTestWallet(t, 3)
+	account := newVerifyTestAccount(t, ownerAddr, firstAddr, secondAddr)
+
+	result, err := provider.VerifyTransaction(newSignedTransaction(t, owner, 2, second, first), h, m, account)
+	require.Nil(t, err)
+	assert.Equal(t, []string{secondAddr, firstAddr}, result.Signers)
+	assert.Equal(t, int64(2), result.Weight)
+
+	result, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 2, first), h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXThresholdNotReached))
+	require.NotNil(t, result)
+	assert.Equal(t, int64(1), result.Weight)
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 2, first, first), h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXInvalidSignature))
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 2, first, owner), h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXInvalidSignature))
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, first, 2, first, second), h, m, account)
+	assert.NotNil(t, err)
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 5, first), h, m, account)
+	assert.NotNil(t, err)
+
+	// the permission only allows transfers
+	freeze := newSignedContractTransaction(t, proto.TXContract_FreezeContractType, owner, 2, first, second)
+	_, err = provider.VerifyTransaction(freeze, h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXOperationNotAllowed))
+
+	freeze = newSignedContractTransaction(t, proto.TXContract_FreezeContractType, owner, 0, owner)
+	_, err = provider.VerifyTransaction(freeze, h, m, account)
+	assert.Nil(t, err)
+}
+
+func Test_VerifyTransaction_Owner(t *testing.T) {
+	h, err := hasher.NewHasher()
+	require.Nil(t, err)
+	m := marshal.NewProtoMarshalizer()
+
+	owner, ownerAddr := newVerifyTestWallet(t, 1)
+	first, firstAddr := newVerifyTestWallet(t, 2)
+	second, secondAddr := newVerifyTestWallet(t, 3)
+
+	// the owner permission moved to two other keys, the sender key no longer signs
+	account := &models.Account{AccountInfo: &models.AccountInfo{
+		Address: ownerAddr,
+		Permissions: []models.Permissions{
+			{ID: 0, Type: models.PermissionTypeOwner, Threshold: 2, Signers: []models.PermissionKey{
+				{Address: firstAddr, Weight: 1},
+				{Address: secondAddr, Weight: 1},
+			}},
+		},
+	}}
+
+	result, err := provider.VerifyTransaction(newSignedTransaction(t, owner, 0, first, second), h, m, account)
+	require.Nil(t, err)
+	assert.Equal(t, []string{firstAddr, secondAddr}, result.Signers)
+	assert.Equal(t, int32(0), result.PermissionID)
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 0, first), h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXThresholdNotReached))
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 0, owner), h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXInvalidSignature))
+
+	tampered := newSignedTransaction(t, owner, 0, first, second)
+	tampered.RawData.Nonce = 2
+	_, err = provider.VerifyTransaction(tampered, h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXHashMismatch))
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 0), h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXNotSigned))
+
+	_, err = provider.VerifyTransaction(&proto.Transaction{}, h, m, account)
+	assert.NotNil(t, err)
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 0, first, second), h, m, nil)
+	assert.NotNil(t, err)
+}
+
+func Test_VerifyTransaction_WithoutPermissions(t *testing.T) {
+	h, err := hasher.NewHasher()
+	require.Nil(t, err)
+	m := marshal.NewProtoMarshalizer()
+
+	owner, ownerAddr := newVerifyTestWallet(t, 1)
+	other, _ := newVerifyTestWallet(t, 2)
+	account := &models.Account{AccountInfo: &models.AccountInfo{Address: ownerAddr, Permissions: []models.Permissions{}}}
+
+	result, err := provider.VerifyTransaction(newSignedTransaction(t, owner, 0, owner), h, m, account)
+	require.Nil(t, err)
+	assert.Equal(t, []string{ownerAddr}, result.Signers)
+	assert.Equal(t, int64(1), result.Threshold)
+
+	_, err = provider.VerifyTransaction(newSignedTransaction(t, owner, 0, other), h, m, account)
+	assert.True(t, errors.Is(err, provider.ErrTXInvalidSignature))
+}
+
+func Test_VerifyTransactionSignatures(t *testing.T) {
+	owner, ownerAddr := newVerifyTestWallet(t, 1)
+	first, firstAddr := newVerifyTestWallet(t, 2)
+	_, secondAddr := newVerifyTestWallet(t, 3)
+	account := newVerifyTestAccount(t, ownerAddr, firstAddr, secondAddr)
+
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/address/"+ownerAddr, r.URL.Path)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"account": account},
+			"code": "successful",
+		})
+	})
+
+	result, err := kc.VerifyTransactionSignatures(context.Background(), newSignedTransaction(t, owner, 0, owner))
+	require.Nil(t, err)
+	assert.Equal(t, []string{ownerAddr}, result.Signers)
+
+	account.Permissions = nil
+	result, err = kc.VerifyTransactionSignatures(context.Background(), newSignedTransaction(t, owner, 0, owner))
+	require.Nil(t, err)
+	assert.Equal(t, []string{ownerAddr}, result.Signers)
+	account = newVerifyTestAccount(t, ownerAddr, firstAddr, secondAddr)
+
+	_, err = kc.VerifyTransactionSignatures(context.Background(), newSignedTransaction(t, owner, 2, first))
+	assert.True(t, errors.Is(err, provider.ErrTXThresholdNotReached))
+}
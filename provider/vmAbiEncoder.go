@@ -0,0 +1,672 @@
+package provider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+// Multi value wrappers only valid for endpoint inputs
+const (
+	abiOptional = "optional"
+	abiMulti    = "multi"
+)
+
+// EncodedArguments holds the hex encoded arguments of a smart contract call
+type EncodedArguments []string
+
+// String returns the arguments joined as appended to the transaction data, e.g. "@01@6b6c76"
+func (e EncodedArguments) String() string {
+	if len(e) == 0 {
+		return ""
+	}
+
+	return "@" + strings.Join(e, "@")
+}
+
+// Arguments returns the arguments in the "type:value" form accepted by
+// InvokeSmartContract and DeploySmartContract
+func (e EncodedArguments) Arguments() []string {
+	args := make([]string, 0, len(e))
+	for _, arg := range e {
+		args = append(args, "hex:"+arg)
+	}
+
+	return args
+}
+
+type VMInputEncoder interface {
+	LoadAbi(r io.Reader) error
+	EncodeEndpoint(endpoint string, args ...interface{}) (EncodedArguments, error)
+	EncodeConstructor(args ...interface{}) (EncodedArguments, error)
+}
+
+type vmInputEncoder struct {
+	abi *vmOutputData
+}
+
+// NewVMInputEncoder creates an encoder that validates Go values against the
+// endpoint input types of an ABI and encodes them to smart contract arguments.
+//
+// Accepted values: Go integers, *big.Int and decimal strings for numbers, string or
+// []byte for buffers, bech32 strings or address.Address for addresses, slices for
+// List and variadic, nil or pointers for Option and optional, maps or structs
+// (fields matched by `abi` tag or name) for structs, and variant name or
+// discriminant for enums. Enum variants with fields are given as a map with
+// the variant name as the only key and a slice of fields as value.
+func NewVMInputEncoder() VMInputEncoder {
+	return &vmInputEncoder{abi: &vmOutputData{}}
+}
+
+func (kc *kleverChain) NewScInputEncoder() VMInputEncoder {
+	return NewVMInputEncoder()
+}
+
+func (e *vmInputEncoder) LoadAbi(r io.Reader) error {
+	return e.abi.LoadAbi(r)
+}
+
+func (e *vmInputEncoder) EncodeEndpoint(endpointName string, args ...interface{}) (EncodedArguments, error) {
+	if !e.abi.AbiLoaded {
+		return nil, fmt.Errorf("before encode any value load your abi with `LoadAbi`")
+	}
+
+	index, err := e.abi.findEndpoint(endpointName)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.encodeInputs(endpointName, e.abi.Endpoints[*index].Inputs, args)
+}
+
+func (e *vmInputEncoder) EncodeConstructor(args ...interface{}) (EncodedArguments, error) {
+	if !e.abi.AbiLoaded {
+		return nil, fmt.Errorf("before encode any value load your abi with `LoadAbi`")
+	}
+
+	return e.encodeInputs("constructor", e.abi.Constructor.Inputs, args)
+}
+
+func (e *vmInputEncoder) encodeInputs(name string, inputs []input, args []interface{}) (EncodedArguments, error) {
+	required := 0
+	for _, in := range inputs {
+		if wrapper, _ := utils.SplitTypes(in.Type); wrapper != abiOptional && wrapper != utils.Variadic {
+			required++
+		}
+	}
+
+	if len(args) < required || len(args) > len(inputs) {
+		if required == len(inputs) {
+			return nil, fmt.Errorf("%s expects %d arguments, got %d", name, required, len(args))
+		}
+
+		return nil, fmt.Errorf("%s expects %d to %d arguments, got %d", name, required, len(inputs), len(args))
+	}
+
+	encoded := make(EncodedArguments, 0, len(args))
+	for i, arg := range args {
+		values, err := e.encodeTopLevel(inputs[i].Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument `%s` of %s: %w", inputs[i].Name, name, err)
+		}
+
+		encoded = append(encoded, values...)
+	}
+
+	return encoded, nil
+}
+
+// encodeTopLevel encodes a value as one or more arguments, only multi value
+// types (optional, variadic and multi) produce a number of arguments other than one
+func (e *vmInputEncoder) encodeTopLevel(fullType string, value interface{}) ([]string, error) {
+	wrapper, valueType := utils.SplitTypes(fullType)
+
+	switch wrapper {
+	case abiOptional:
+		v, isSome := unwrapOption(value)
+		if !isSome {
+			return nil, nil
+		}
+		return e.encodeTopLevel(valueType, v)
+	case utils.Variadic:
+		items, err := sliceItems(value)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded := make([]string, 0, len(items))
+		for _, item := range items {
+			values, err := e.encodeTopLevel(valueType, item)
+			if err != nil {
+				return nil, err
+			}
+			encoded = append(encoded, values...)
+		}
+		return encoded, nil
+	case abiMulti:
+		types := utils.SplitTupleTypes(valueType)
+		items, err := sliceItems(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(types) {
+			return nil, fmt.Errorf("%s expects %d values, got %d", fullType, len(types), len(items))
+		}
+
+		encoded := make([]string, 0, len(items))
+		for i, item := range items {
+			values, err := e.encodeTopLevel(types[i], item)
+			if err != nil {
+				return nil, err
+			}
+			encoded = append(encoded, values...)
+		}
+		return encoded, nil
+	}
+
+	b, err := e.encode(fullType, value, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{hex.EncodeToString(b)}, nil
+}
+
+func (e *vmInputEncoder) encode(fullType string, value interface{}, nested bool) ([]byte, error) {
+	if fullType == utils.VecU8 {
+		return e.encodeSingleValue(fullType, value, nested)
+	}
+
+	wrapper, valueType := utils.SplitTypes(fullType)
+
+	switch wrapper {
+	case "":
+	case utils.Option:
+		v, isSome := unwrapOption(value)
+		if !isSome {
+			if nested {
+				return []byte{0}, nil
+			}
+			return []byte{}, nil
+		}
+
+		encoded, err := e.encode(valueType, v, true)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{1}, encoded...), nil
+	case utils.List:
+		items, err := sliceItems(value)
+		if err != nil {
+			return nil, err
+		}
+
+		var encoded []byte
+		if nested {
+			encoded = lengthPrefix(len(items))
+		}
+		for _, item := range items {
+			b, err := e.encode(valueType, item, true)
+			if err != nil {
+				return nil, err
+			}
+			encoded = append(encoded, b...)
+		}
+		return encoded, nil
+	case utils.Tuple:
+		types := utils.SplitTupleTypes(valueType)
+		items, err := sliceItems(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(types) {
+			return nil, fmt.Errorf("%s expects %d values, got %d", fullType, len(types), len(items))
+		}
+
+		var encoded []byte
+		for i, item := range items {
+			b, err := e.encode(types[i], item, true)
+			if err != nil {
+				return nil, err
+			}
+			encoded = append(encoded, b...)
+		}
+		return encoded, nil
+	default:
+		return nil, fmt.Errorf("type %s can only be used as an endpoint input", fullType)
+	}
+
+	return e.encodeSingleValue(valueType, value, nested)
+}
+
+func (e *vmInputEncoder) encodeSingleValue(valueType string, value interface{}, nested bool) ([]byte, error) {
+	switch valueType {
+	case utils.Int8:
+		return encodeFixedInt(value, utils.Bits8, true, nested)
+	case utils.Int16:
+		return encodeFixedInt(value, utils.Bits16, true, nested)
+	case utils.Int32, utils.Isize:
+		return encodeFixedInt(value, utils.Bits32, true, nested)
+	case utils.Int64:
+		return encodeFixedInt(value, utils.Bits64, true, nested)
+	case utils.Uint8:
+		return encodeFixedInt(value, utils.Bits8, false, nested)
+	case utils.Uint16:
+		return encodeFixedInt(value, utils.Bits16, false, nested)
+	case utils.Uint32, utils.Usize:
+		return encodeFixedInt(value, utils.Bits32, false, nested)
+	case utils.Uint64:
+		return encodeFixedInt(value, utils.Bits64, false, nested)
+	case utils.BigUint:
+		bi, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		if bi.Sign() < 0 {
+			return nil, fmt.Errorf("negative value %s for %s", bi.String(), valueType)
+		}
+		return withLength(bi.Bytes(), nested), nil
+	case utils.BigInt:
+		bi, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		return withLength(signedBytes(bi), nested), nil
+	case utils.BigFloat:
+		bf, err := toBigFloat(value)
+		if err != nil {
+			return nil, err
+		}
+		b, err := bf.GobEncode()
+		if err != nil {
+			return nil, err
+		}
+		return withLength(b, nested), nil
+	case utils.Address:
+		return toAddressBytes(value)
+	case utils.Boolean:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+		if b {
+			return []byte{1}, nil
+		}
+		if nested {
+			return []byte{0}, nil
+		}
+		return []byte{}, nil
+	case
+		utils.ManagedBuffer,
+		utils.TokenIdentifier,
+		utils.Bytes,
+		utils.BoxedBytes,
+		utils.String,
+		utils.StrRef,
+		utils.VecU8,
+		utils.SliceU8:
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return withLength(b, nested), nil
+	default:
+		typeDef, exists := e.abi.Types[valueType]
+		if !exists {
+			return nil, fmt.Errorf("type %s not found in provided abi", valueType)
+		}
+
+		if typeDef.Type == "enum" {
+			return e.encodeEnum(valueType, typeDef.EnumVariants, value, nested)
+		}
+		return e.encodeStruct(valueType, typeDef.StructFields, value)
+	}
+}
+
+func (e *vmInputEncoder) encodeStruct(typeName string, fields []field, value interface{}) ([]byte, error) {
+	var encoded []byte
+	for _, f := range fields {
+		v, err := structField(value, f.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", typeName, err)
+		}
+
+		b, err := e.encode(f.Type, v, true)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typeName, f.Name, err)
+		}
+		encoded = append(encoded, b...)
+	}
+
+	return encoded, nil
+}
+
+func (e *vmInputEncoder) encodeEnum(typeName string, variants []enumVariant, value interface{}, nested bool) ([]byte, error) {
+	var variant *enumVariant
+	var fields []interface{}
+
+	findVariant := func(match func(v enumVariant) bool) {
+		for i := range variants {
+			if match(variants[i]) {
+				variant = &variants[i]
+				return
+			}
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	switch {
+	case value == nil:
+		return nil, fmt.Errorf("missing value for enum %s", typeName)
+	case rv.Kind() == reflect.String:
+		name := rv.String()
+		findVariant(func(v enumVariant) bool { return v.Name == name })
+	case rv.Kind() == reflect.Map:
+		if rv.Len() != 1 || rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("enum %s with fields must be a map with the variant name as the only key", typeName)
+		}
+
+		key := rv.MapKeys()[0]
+		name := key.String()
+		findVariant(func(v enumVariant) bool { return v.Name == name })
+
+		items, err := sliceItems(rv.MapIndex(key).Interface())
+		if err != nil {
+			return nil, err
+		}
+		fields = items
+	default:
+		discriminant, err := toBigInt(value)
+		if err != nil || !discriminant.IsUint64() {
+			return nil, fmt.Errorf("invalid value %v for enum %s", value, typeName)
+		}
+		findVariant(func(v enumVariant) bool { return uint64(v.Discriminant) == discriminant.Uint64() })
+	}
+
+	if variant == nil {
+		return nil, fmt.Errorf("invalid variant %v for enum %s", value, typeName)
+	}
+
+	if len(fields) != len(variant.EnumFields) {
+		return nil, fmt.Errorf("variant %s of %s expects %d fields, got %d", variant.Name, typeName, len(variant.EnumFields), len(fields))
+	}
+
+	encoded := []byte{byte(variant.Discriminant)}
+	if !nested && len(variant.EnumFields) == 0 && variant.Discriminant == 0 {
+		encoded = []byte{}
+	}
+
+	for i, f := range variant.EnumFields {
+		b, err := e.encode(f.Type, fields[i], true)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typeName, variant.Name, err)
+		}
+		encoded = append(encoded, b...)
+	}
+
+	return encoded, nil
+}
+
+func lengthPrefix(length int) []byte {
+	return []byte{byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length)}
+}
+
+func withLength(b []byte, nested bool) []byte {
+	if !nested {
+		return b
+	}
+
+	return append(lengthPrefix(len(b)), b...)
+}
+
+// encodeFixedInt encodes with the type width when nested, and as the minimal
+// big endian (two's complement when signed) representation at top level
+func encodeFixedInt(value interface{}, bits int, signed bool, nested bool) ([]byte, error) {
+	bi, err := toBigInt(value)
+	if err != nil {
+		return nil, err
+	}
+
+	min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	max.Sub(max, big.NewInt(1))
+
+	if bi.Cmp(min) < 0 || bi.Cmp(max) > 0 {
+		return nil, fmt.Errorf("value %s out of range [%s, %s]", bi.String(), min.String(), max.String())
+	}
+
+	if !nested {
+		if signed {
+			return signedBytes(bi), nil
+		}
+		return bi.Bytes(), nil
+	}
+
+	// two's complement on the full width
+	if bi.Sign() < 0 {
+		bi = new(big.Int).Add(bi, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	}
+
+	encoded := make([]byte, bits/8)
+	bi.FillBytes(encoded)
+
+	return encoded, nil
+}
+
+// signedBytes returns the minimal two's complement representation, empty for zero
+func signedBytes(bi *big.Int) []byte {
+	switch bi.Sign() {
+	case 0:
+		return []byte{}
+	case 1:
+		b := bi.Bytes()
+		if b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	default:
+		length := (new(big.Int).Add(bi, big.NewInt(1)).BitLen())/8 + 1
+		twos := new(big.Int).Add(bi, new(big.Int).Lsh(big.NewInt(1), uint(length*8)))
+		b := make([]byte, length)
+		twos.FillBytes(b)
+		return b
+	}
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return nil, fmt.Errorf("nil big.Int")
+		}
+		return new(big.Int).Set(v), nil
+	case big.Int:
+		return new(big.Int).Set(&v), nil
+	case string:
+		bi, ok := new(big.Int).SetString(v, utils.BaseDecimal)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer `%s`", v)
+		}
+		return bi, nil
+	case json.Number:
+		return toBigInt(v.String())
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		bf := big.NewFloat(f)
+		if !bf.IsInt() {
+			return nil, fmt.Errorf("value %v is not an integer", f)
+		}
+		bi, _ := bf.Int(nil)
+		return bi, nil
+	}
+
+	return nil, fmt.Errorf("expected integer, got %T", value)
+}
+
+func toBigFloat(value interface{}) (*big.Float, error) {
+	var bf *big.Float
+
+	switch v := value.(type) {
+	case *big.Float:
+		bf = new(big.Float).Set(v)
+	case float64:
+		bf = big.NewFloat(v)
+	case float32:
+		bf = big.NewFloat(float64(v))
+	case string:
+		parsed, ok := new(big.Float).SetString(v)
+		if !ok {
+			return nil, fmt.Errorf("invalid float `%s`", v)
+		}
+		bf = parsed
+	default:
+		bi, err := toBigInt(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected float, got %T", value)
+		}
+		bf = new(big.Float).SetInt(bi)
+	}
+
+	bf.SetPrec(utils.BigFloatVMPrecision)
+	bf.SetMode(big.RoundingMode(big.Exact))
+
+	return bf, nil
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	case fmt.Stringer:
+		return []byte(v.String()), nil
+	}
+
+	return nil, fmt.Errorf("expected string or []byte, got %T", value)
+}
+
+func toAddressBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		addr, err := address.NewAddress(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address `%s`", v)
+		}
+		return addr.Bytes(), nil
+	case address.Address:
+		return v.Bytes(), nil
+	case []byte:
+		if len(v) != utils.AddressHexLen/2 {
+			return nil, fmt.Errorf("invalid address length %d", len(v))
+		}
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("expected address, got %T", value)
+}
+
+// unwrapOption returns the wrapped value and false for None (nil or nil pointer)
+func unwrapOption(value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, false
+		}
+
+		// keep pointers the encoder understands as values
+		switch value.(type) {
+		case *big.Int, *big.Float:
+			return value, true
+		}
+
+		return rv.Elem().Interface(), true
+	}
+
+	return value, true
+}
+
+func sliceItems(value interface{}) ([]interface{}, error) {
+	if items, ok := value.([]interface{}); ok {
+		return items, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected slice, got %T", value)
+	}
+
+	items := make([]interface{}, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		items = append(items, rv.Index(i).Interface())
+	}
+
+	return items, nil
+}
+
+// structField reads an ABI field from a map or from a Go struct, struct fields
+// are matched by the `abi` tag or by name ignoring case and underscores
+func structField(value interface{}, name string) (interface{}, error) {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("nil value")
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map keys must be strings")
+		}
+
+		v := rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+		if !v.IsValid() {
+			return nil, fmt.Errorf("missing field `%s`", name)
+		}
+		return v.Interface(), nil
+	case reflect.Struct:
+		normalized := normalizeFieldName(name)
+		for i := 0; i < rv.NumField(); i++ {
+			f := rv.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			tag, _, _ := strings.Cut(f.Tag.Get("abi"), ",")
+			if tag == name || (len(tag) == 0 && normalizeFieldName(f.Name) == normalized) {
+				return rv.Field(i).Interface(), nil
+			}
+		}
+		return nil, fmt.Errorf("missing field `%s`", name)
+	}
+
+	return nil, fmt.Errorf("expected map or struct, got %T", value)
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,288 @@
+package provider_test
+
+import (
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/provider"
+)
+
+const (
+	encoderTestAddress    = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+	encoderTestAddressHex = "e41b323a571fd955e09cd41660ff4465c3f44693c87f2faea4a0fc408727c8ea"
+)
+
+const encoderTestAbi = `{
+	"constructor": {"inputs": [{"name": "owner", "type": "Address"}, {"name": "fee", "type": "u8"}], "outputs": []},
+	"endpoints": [
+		{"name": "setInfo", "mutability": "mutable", "inputs": [{"name": "info", "type": "Info"}], "outputs": []},
+		{"name": "setStatus", "mutability": "mutable", "inputs": [{"name": "status", "type": "Status"}], "outputs": []},
+		{"name": "setAction", "mutability": "mutable", "inputs": [{"name": "action", "type": "Action"}], "outputs": []},
+		{"name": "numbers", "mutability": "mutable", "inputs": [
+			{"name": "a", "type": "i8"},
+			{"name": "b", "type": "i64"},
+			{"name": "c", "type": "BigInt"},
+			{"name": "d", "type": "bool"},
+			{"name": "e", "type": "tuple<u16,bytes>"},
+			{"name": "f", "type": "List<u64>"}
+		], "outputs": []},
+		{"name": "addMany", "mutability": "mutable", "inputs": [{"name": "values", "type": "variadic<u32>", "multi_arg": true}], "outputs": []}
+	],
+	"types": {
+		"Info": {"type": "struct", "fields": [
+			{"name": "token_identifier", "type": "TokenIdentifier"},
+			{"name": "amount", "type": "BigUint"},
+			{"name": "deadline", "type": "u64"},
+			{"name": "status", "type": "Status"},
+			{"name": "winner", "type": "Option<Address>"}
+		]},
+		"Status": {"type": "enum", "variants": [
+			{"name": "Inactive", "discriminant": 0},
+			{"name": "Running", "discriminant": 1},
+			{"name": "Ended", "discriminant": 2}
+		]},
+		"Action": {"type": "enum", "variants": [
+			{"name": "None", "discriminant": 0},
+			{"name": "Transfer", "discriminant": 1, "fields": [{"name": "0", "type": "Address"}, {"name": "1", "type": "u32"}]}
+		]}
+	}
+}`
+
+func newTestInputEncoder(t *testing.T) provider.VMInputEncoder {
+	encoder := provider.NewVMInputEncoder()
+	require.Nil(t, encoder.LoadAbi(strings.NewReader(encoderTestAbi)))
+
+	return encoder
+}
+
+func Test_Encode_Lottery_Start(t *testing.T) {
+	jsonAbi, errOpen := os.Open("../cmd/demo/smartContracts/scFiles/lottery-kda.abi.json")
+	require.Nil(t, errOpen, "error opening abi", errOpen)
+	defer jsonAbi.Close()
+
+	encoder := provider.NewVMInputEncoder()
+	require.Nil(t, encoder.LoadAbi(jsonAbi))
+
+	maxEntries := uint32(5)
+	encoded, err := encoder.EncodeEndpoint(
+		"start",
+		"lottery",
+		"KLV",
+		big.NewInt(1000000),
+		nil,
+		uint64(1700000000),
+		&maxEntries,
+		nil,
+		[]string{encoderTestAddress},
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, provider.EncodedArguments{
+		"6c6f7474657279",
+		"4b4c56",
+		"0f4240",
+		"",
+		"01000000006553f100",
+		"0100000005",
+		"",
+		"01" + "00000001" + encoderTestAddressHex,
+	}, encoded)
+	assert.Equal(t, "@6c6f7474657279@4b4c56@0f4240@@01000000006553f100@0100000005@@0100000001"+encoderTestAddressHex, encoded.String())
+	assert.Equal(t, "hex:6c6f7474657279", encoded.Arguments()[0])
+
+	withBurn, err := encoder.EncodeEndpoint("start", "lottery", "KLV", 1000000, nil, nil, nil, nil, nil, "10")
+	require.Nil(t, err)
+	assert.Len(t, withBurn, 9)
+	assert.Equal(t, "0a", withBurn[8])
+}
+
+func Test_Encode_Numbers(t *testing.T) {
+	encoder := newTestInputEncoder(t)
+
+	encoded, err := encoder.EncodeEndpoint(
+		"numbers",
+		-1,
+		int64(0),
+		"-129",
+		true,
+		[]interface{}{uint16(258), []byte("ab")},
+		[]uint64{1, 2},
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, provider.EncodedArguments{
+		"ff",
+		"",
+		"ff7f",
+		"01",
+		"0102" + "00000002" + "6162",
+		"0000000000000001" + "0000000000000002",
+	}, encoded)
+}
+
+func Test_Encode_Invalid_Values(t *testing.T) {
+	encoder := newTestInputEncoder(t)
+
+	testCases := []struct {
+		name string
+		args []interface{}
+	}{
+		{name: "Out_Of_Range", args: []interface{}{128, 0, 0, false, []interface{}{1, "a"}, []int{}}},
+		{name: "Wrong_Type", args: []interface{}{1, 0, 0, "true", []interface{}{1, "a"}, []int{}}},
+		{name: "Tuple_Length", args: []interface{}{1, 0, 0, false, []interface{}{1}, []int{}}},
+		{name: "Not_A_List", args: []interface{}{1, 0, 0, false, []interface{}{1, "a"}, 1}},
+		{name: "Missing_Arguments", args: []interface{}{1, 0}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := encoder.EncodeEndpoint("numbers", tc.args...)
+			assert.NotNil(t, err)
+		})
+	}
+
+	_, err := encoder.EncodeEndpoint("numbers", 1, 0)
+	assert.EqualError(t, err, "numbers expects 6 arguments, got 2")
+
+	_, err = encoder.EncodeEndpoint("addMany", []uint32{1}, []uint32{2})
+	assert.EqualError(t, err, "addMany expects 0 to 1 arguments, got 2")
+
+	_, err = encoder.EncodeEndpoint("unknown")
+	assert.NotNil(t, err)
+
+	_, err = provider.NewVMInputEncoder().EncodeEndpoint("numbers")
+	assert.NotNil(t, err)
+}
+
+func Test_Encode_Struct(t *testing.T) {
+	encoder := newTestInputEncoder(t)
+
+	expected := "00000003" + "4b4c56" +
+		"00000002" + "03e8" +
+		"0000000000000064" +
+		"01" +
+		"01" + encoderTestAddressHex
+
+	fromMap, err := encoder.EncodeEndpoint("setInfo", map[string]interface{}{
+		"token_identifier": "KLV",
+		"amount":           big.NewInt(1000),
+		"deadline":         100,
+		"status":           "Running",
+		"winner":           encoderTestAddress,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, provider.EncodedArguments{expected}, fromMap)
+
+	type info struct {
+		TokenIdentifier string
+		Amount          *big.Int
+		Deadline        uint64
+		State           int     `abi:"status"`
+		Winner          *string `abi:"winner"`
+	}
+
+	winner := encoderTestAddress
+	fromStruct, err := encoder.EncodeEndpoint("setInfo", info{
+		TokenIdentifier: "KLV",
+		Amount:          big.NewInt(1000),
+		Deadline:        100,
+		State:           1,
+		Winner:          &winner,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, fromMap, fromStruct)
+
+	_, err = encoder.EncodeEndpoint("setInfo", map[string]interface{}{"token_identifier": "KLV"})
+	assert.NotNil(t, err)
+}
+
+func Test_Encode_Enum(t *testing.T) {
+	encoder := newTestInputEncoder(t)
+
+	encoded, err := encoder.EncodeEndpoint("setStatus", "Ended")
+	require.Nil(t, err)
+	assert.Equal(t, provider.EncodedArguments{"02"}, encoded)
+
+	encoded, err = encoder.EncodeEndpoint("setStatus", 0)
+	require.Nil(t, err)
+	assert.Equal(t, provider.EncodedArguments{""}, encoded)
+
+	_, err = encoder.EncodeEndpoint("setStatus", "Paused")
+	assert.NotNil(t, err)
+
+	encoded, err = encoder.EncodeEndpoint("setAction", map[string][]interface{}{
+		"Transfer": {encoderTestAddress, 7},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, provider.EncodedArguments{"01" + encoderTestAddressHex + "00000007"}, encoded)
+
+	_, err = encoder.EncodeEndpoint("setAction", map[string][]interface{}{"Transfer": {encoderTestAddress}})
+	assert.NotNil(t, err)
+}
+
+func Test_Encode_Variadic_And_Constructor(t *testing.T) {
+	encoder := newTestInputEncoder(t)
+
+	encoded, err := encoder.EncodeEndpoint("addMany", []uint32{1, 256})
+	require.Nil(t, err)
+	assert.Equal(t, provider.EncodedArguments{"01", "0100"}, encoded)
+
+	encoded, err = encoder.EncodeEndpoint("addMany")
+	require.Nil(t, err)
+	assert.Empty(t, encoded)
+	assert.Equal(t, "", encoded.String())
+
+	encoded, err = encoder.EncodeConstructor(encoderTestAddress, 10)
+	require.Nil(t, err)
+	assert.Equal(t, "@"+encoderTestAddressHex+"@0a", encoded.String())
+}
+
+func Test_Encode_Input_Raw_Hex(t *testing.T) {
+	encoded, err := provider.EncodeInput([]string{"hex:0A0b", "u16:1"})
+	require.Nil(t, err)
+	assert.Equal(t, "@0a0b@01", encoded)
+
+	_, err = provider.EncodeInput([]string{"raw:zz"})
+	assert.NotNil(t, err)
+}
+
+// the typed input encoder fixes below changed the baseline behaviour of EncodeInput
+// EncodeInput keeps the output it always had, VMInputEncoder follows the abi widths
+func Test_Encode_Input_Nested_Widths(t *testing.T) {
+	testCases := []struct {
+		arg      string
+		expected string
+	}{
+		{"optionu8:5", "@010000000000000005"},
+		{"optionu16:5", "@010000000000000005"},
+		{"optionu32:5", "@010000000000000005"},
+		{"optionusize:5", "@010000000000000005"},
+		{"optionu64:5", "@010000000000000005"},
+		{"optioni8:-1", "@01ff"},
+		{"optioni32:-1", "@01ffffffff"},
+		{"optionisize:-1", "@01ffffffffffffffff"},
+	}
+
+	for _, tc := range testCases {
+		encoded, err := provider.EncodeInput([]string{tc.arg})
+		require.Nil(t, err, tc.arg)
+		assert.Equal(t, tc.expected, encoded, tc.arg)
+	}
+}
+
+func Test_Encode_Input_Uppercase_Types(t *testing.T) {
+	for _, arg := range []string{"u16:256", "u32:256", "U8:1", "U64:256"} {
+		_, err := provider.EncodeInput([]string{arg})
+		require.Nil(t, err, arg)
+	}
+
+	for _, arg := range []string{"U16:256", "U32:256"} {
+		_, err := provider.EncodeInput([]string{arg})
+		assert.Contains(t, err.Error(), "invalid encode type", arg)
+	}
+}
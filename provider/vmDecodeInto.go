@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+)
+
+// DecodeInto copies a value returned by VMOutputData.DecodeHex into target, which must be a pointer.
+// Structs are filled from the decoded maps matching fields by the `abi` tag or by name ignoring
+// case and underscores, Option values are nil or set on pointers and single results are
+// accepted by slices, as returned by variadic outputs with one item
+func DecodeInto(decoded interface{}, target interface{}) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("decode target must be a non nil pointer, got %T", target)
+	}
+
+	return assignDecoded(decoded, rv.Elem())
+}
+
+func assignDecoded(decoded interface{}, target reflect.Value) error {
+	if decoded == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	src := reflect.ValueOf(decoded)
+	if src.Type().AssignableTo(target.Type()) {
+		target.Set(src)
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Pointer:
+		value := reflect.New(target.Type().Elem())
+		if err := assignDecoded(decoded, value.Elem()); err != nil {
+			return err
+		}
+		target.Set(value)
+		return nil
+	case reflect.Struct:
+		switch target.Type() {
+		case bigIntType, bigFloatType:
+			if src.Kind() == reflect.Pointer && src.Type().Elem() == target.Type() {
+				target.Set(src.Elem())
+				return nil
+			}
+			return fmt.Errorf("cannot decode %T into %s", decoded, target.Type())
+		}
+
+		fields, ok := decoded.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", decoded, target.Type())
+		}
+		return assignStruct(fields, target)
+	case reflect.Slice:
+		if target.Type().Elem().Kind() == reflect.Uint8 && src.Kind() == reflect.String {
+			target.SetBytes([]byte(src.String()))
+			return nil
+		}
+
+		items, err := sliceItems(decoded)
+		if err != nil {
+			// variadic outputs with a single result are not decoded as lists
+			items = []interface{}{decoded}
+		}
+
+		slice := reflect.MakeSlice(target.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := assignDecoded(item, slice.Index(i)); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		target.Set(slice)
+		return nil
+	case reflect.String:
+		if src.Kind() == reflect.String {
+			target.SetString(src.String())
+			return nil
+		}
+	case reflect.Bool:
+		if src.Kind() == reflect.Bool {
+			target.SetBool(src.Bool())
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bi, err := toBigInt(decoded)
+		if err != nil || !bi.IsInt64() || target.OverflowInt(bi.Int64()) {
+			return fmt.Errorf("cannot decode %v into %s", decoded, target.Type())
+		}
+		target.SetInt(bi.Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bi, err := toBigInt(decoded)
+		if err != nil || !bi.IsUint64() || target.OverflowUint(bi.Uint64()) {
+			return fmt.Errorf("cannot decode %v into %s", decoded, target.Type())
+		}
+		target.SetUint(bi.Uint64())
+		return nil
+	}
+
+	return fmt.Errorf("cannot decode %T into %s", decoded, target.Type())
+}
+
+func assignStruct(fields map[string]interface{}, target reflect.Value) error {
+	normalized := make(map[string]interface{}, len(fields))
+	for name, value := range fields {
+		normalized[normalizeFieldName(name)] = value
+	}
+
+	for i := 0; i < target.NumField(); i++ {
+		f := target.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(f.Tag.Get("abi"), ",")
+
+		var value interface{}
+		var exists bool
+		if len(tag) != 0 {
+			value, exists = fields[tag]
+		} else {
+			value, exists = normalized[normalizeFieldName(f.Name)]
+		}
+
+		if !exists {
+			continue
+		}
+
+		if err := assignDecoded(value, target.Field(i)); err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+
+	return nil
+}
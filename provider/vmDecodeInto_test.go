@@ -0,0 +1,57 @@
+package provider_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/provider"
+)
+
+func Test_Decode_Into(t *testing.T) {
+	type status string
+
+	type info struct {
+		TokenIdentifier string
+		Amount          *big.Int
+		Deadline        uint64
+		Status          status
+		Winner          *string `abi:"winner"`
+		Tickets         []uint32
+	}
+
+	var result info
+	err := provider.DecodeInto(map[string]interface{}{
+		"token_identifier": "KLV",
+		"amount":           big.NewInt(1000),
+		"deadline":         uint64(100),
+		"status":           "Running",
+		"winner":           nil,
+		"tickets":          []interface{}{uint32(1), uint32(2)},
+	}, &result)
+	require.Nil(t, err)
+
+	assert.Equal(t, info{
+		TokenIdentifier: "KLV",
+		Amount:          big.NewInt(1000),
+		Deadline:        100,
+		Status:          "Running",
+		Tickets:         []uint32{1, 2},
+	}, result)
+
+	var single []string
+	require.Nil(t, provider.DecodeInto("klv", &single))
+	assert.Equal(t, []string{"klv"}, single)
+
+	var option *uint64
+	require.Nil(t, provider.DecodeInto(uint64(7), &option))
+	require.NotNil(t, option)
+	assert.Equal(t, uint64(7), *option)
+
+	var small uint8
+	assert.NotNil(t, provider.DecodeInto(uint32(256), &small))
+	assert.NotNil(t, provider.DecodeInto("a", &small))
+	assert.NotNil(t, provider.DecodeInto(1, small))
+}
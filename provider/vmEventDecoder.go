@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+// ErrEventNotFound is returned by DecodeEvent when the log entry matches no event of the abi
+var ErrEventNotFound = errors.New("event not found in abi")
+
+// DecodedEvent is a smart contract log entry decoded with the abi events,
+// Fields holds the event inputs by name
+type DecodedEvent struct {
+	Address    string
+	Identifier string
+	Fields     map[string]interface{}
+}
+
+// DecodeEvent matches a log entry to an abi event by its first topic and decodes the indexed
+// inputs from the remaining topics and the others from data. Entries whose first topic is no
+// event of the abi are matched by their identifier, all their topics are then indexed inputs
+func (a *vmOutputData) DecodeEvent(logEvent *models.SCEvent) (*DecodedEvent, error) {
+	if !a.AbiLoaded {
+		return nil, fmt.Errorf("before decode any value load your abi with `LoadAbi`")
+	}
+
+	if logEvent == nil {
+		return nil, fmt.Errorf("nil event")
+	}
+
+	topics, err := base64ToHex(logEvent.Topics)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event topics: %w", err)
+	}
+
+	data, err := base64ToHex(logEvent.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event data: %w", err)
+	}
+
+	abiEvent, inTopics, err := a.findEvent(logEvent.Identifier, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	if !inTopics {
+		topics = append([]string{""}, topics...)
+	}
+
+	var indexed, notIndexed []eventInput
+	for _, in := range abiEvent.Inputs {
+		if in.Indexed {
+			indexed = append(indexed, in)
+		} else {
+			notIndexed = append(notIndexed, in)
+		}
+	}
+
+	if len(topics) < len(indexed)+1 {
+		return nil, fmt.Errorf("event %s expects %d indexed topics, got %d", abiEvent.Identifier, len(indexed), len(topics)-1)
+	}
+
+	decoded := &DecodedEvent{
+		Address:    logEvent.Address,
+		Identifier: abiEvent.Identifier,
+		Fields:     make(map[string]interface{}, len(abiEvent.Inputs)),
+	}
+
+	for i, in := range indexed {
+		topic := emptyAsZero(topics[i+1], in.Type)
+		value, err := a.doDecode(&topic, in.Type, 0)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding topic %s of event %s: %w", in.Name, abiEvent.Identifier, err)
+		}
+		decoded.Fields[in.Name] = value
+	}
+
+	switch {
+	case len(notIndexed) == 0:
+	case len(data) == len(notIndexed):
+		for i, in := range notIndexed {
+			item := emptyAsZero(data[i], in.Type)
+			value, err := a.doDecode(&item, in.Type, 0)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding data %s of event %s: %w", in.Name, abiEvent.Identifier, err)
+			}
+			decoded.Fields[in.Name] = value
+		}
+	case len(data) == 1:
+		// all inputs nested encoded in a single data item
+		fields := make([]field, 0, len(notIndexed))
+		for _, in := range notIndexed {
+			fields = append(fields, field{Name: in.Name, Type: in.Type})
+		}
+
+		values, err := a.decodeStruct(&data[0], fields)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding data of event %s: %w", abiEvent.Identifier, err)
+		}
+		for name, value := range values {
+			decoded.Fields[name] = value
+		}
+	default:
+		return nil, fmt.Errorf("event %s expects %d data items, got %d", abiEvent.Identifier, len(notIndexed), len(data))
+	}
+
+	return decoded, nil
+}
+
+// findEvent returns the abi event of the log entry and whether it was matched by the first topic
+func (a *vmOutputData) findEvent(identifier string, topics []string) (*event, bool, error) {
+	if len(topics) != 0 {
+		if b, err := hex.DecodeString(topics[0]); err == nil {
+			if e := a.eventByIdentifier(string(b)); e != nil {
+				return e, true, nil
+			}
+		}
+	}
+
+	if e := a.eventByIdentifier(identifier); e != nil {
+		return e, false, nil
+	}
+
+	return nil, false, fmt.Errorf("%w: %s", ErrEventNotFound, identifier)
+}
+
+func (a *vmOutputData) eventByIdentifier(identifier string) *event {
+	if len(identifier) == 0 {
+		return nil
+	}
+
+	for i := range a.Events {
+		if a.Events[i].Identifier == identifier {
+			return &a.Events[i]
+		}
+	}
+
+	return nil
+}
+
+// DecodeTransactionEvents decodes the logs and receipts of a transaction,
+// entries from events not present in the abi are skipped
+func (a *vmOutputData) DecodeTransactionEvents(tx *models.TransactionAPI) ([]*DecodedEvent, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("nil transaction")
+	}
+
+	var logEvents []*models.SCEvent
+	if tx.Logs != nil {
+		logEvents = append(logEvents, tx.Logs.Events...)
+	}
+
+	receiptEvents, err := eventsFromReceipts(tx.Receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.decodeEvents(append(logEvents, receiptEvents...))
+}
+
+// DecodeReceiptEvents finds the smart contract log entries in raw receipts and decodes them,
+// entries from events not present in the abi are skipped
+func (a *vmOutputData) DecodeReceiptEvents(receipts []map[string]interface{}) ([]*DecodedEvent, error) {
+	logEvents, err := eventsFromReceipts(receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.decodeEvents(logEvents)
+}
+
+func (a *vmOutputData) decodeEvents(logEvents []*models.SCEvent) ([]*DecodedEvent, error) {
+	decodedEvents := make([]*DecodedEvent, 0, len(logEvents))
+	for _, logEvent := range logEvents {
+		decoded, err := a.DecodeEvent(logEvent)
+		if errors.Is(err, ErrEventNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		decodedEvents = append(decodedEvents, decoded)
+	}
+
+	return decodedEvents, nil
+}
+
+// eventsFromReceipts returns the receipts that are log entries, or hold them in an events list
+func eventsFromReceipts(receipts []map[string]interface{}) ([]*models.SCEvent, error) {
+	var logEvents []*models.SCEvent
+
+	for _, receipt := range receipts {
+		var entries []interface{}
+		if events, ok := receipt["events"].([]interface{}); ok {
+			entries = events
+		} else {
+			entries = []interface{}{receipt}
+		}
+
+		for _, entry := range entries {
+			fields, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, hasTopics := fields["topics"]; !hasTopics {
+				continue
+			}
+			if _, hasIdentifier := fields["identifier"]; !hasIdentifier {
+				continue
+			}
+
+			b, err := json.Marshal(fields)
+			if err != nil {
+				return nil, err
+			}
+
+			logEvent := &models.SCEvent{}
+			if err := json.Unmarshal(b, logEvent); err != nil {
+				return nil, fmt.Errorf("invalid log entry in receipts: %w", err)
+			}
+			logEvents = append(logEvents, logEvent)
+		}
+	}
+
+	return logEvents, nil
+}
+
+// emptyAsZero returns a zero byte for numbers, encoded as empty values at top level
+func emptyAsZero(hexValue string, valueType string) string {
+	if len(hexValue) != 0 {
+		return hexValue
+	}
+
+	switch valueType {
+	case
+		utils.Int8, utils.Int16, utils.Int32, utils.Isize, utils.Int64,
+		utils.Uint8, utils.Uint16, utils.Uint32, utils.Usize, utils.Uint64,
+		utils.BigInt, utils.BigUint:
+		return "00"
+	}
+
+	return hexValue
+}
+
+func base64ToHex(items []string) ([]string, error) {
+	hexItems := make([]string, 0, len(items))
+	for _, item := range items {
+		b, err := base64.StdEncoding.DecodeString(item)
+		if err != nil {
+			return nil, err
+		}
+		hexItems = append(hexItems, hex.EncodeToString(b))
+	}
+
+	return hexItems, nil
+}
@@ -0,0 +1,162 @@
+package provider_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider"
+)
+
+const eventTestAbi = `{
+	"name": "Lottery",
+	"endpoints": [],
+	"types": {},
+	"events": [
+		{"identifier": "buy_ticket", "inputs": [
+			{"name": "caller", "type": "Address", "indexed": true},
+			{"name": "lottery_name", "type": "bytes", "indexed": true},
+			{"name": "amount", "type": "BigUint"}
+		]},
+		{"identifier": "winner", "inputs": [
+			{"name": "ticket", "type": "u32", "indexed": true},
+			{"name": "prize", "type": "BigUint"},
+			{"name": "token", "type": "TokenIdentifier"}
+		]}
+	]
+}`
+
+func b64(t *testing.T, hexValue string) string {
+	b, err := hex.DecodeString(hexValue)
+	require.Nil(t, err)
+
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func newEventDecoder(t *testing.T) provider.VMOutputData {
+	decoder := provider.NewVMOutputHandler()
+	require.Nil(t, decoder.LoadAbi(strings.NewReader(eventTestAbi)))
+
+	return decoder
+}
+
+func Test_Decode_Event(t *testing.T) {
+	decoder := newEventDecoder(t)
+
+	decoded, err := decoder.DecodeEvent(&models.SCEvent{
+		Address:    queryTestScAddress,
+		Identifier: "buy_ticket",
+		Topics: []string{
+			base64.StdEncoding.EncodeToString([]byte("buy_ticket")),
+			b64(t, encoderTestAddressHex),
+			base64.StdEncoding.EncodeToString([]byte("demo")),
+		},
+		Data: []string{b64(t, "03e8")},
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, &provider.DecodedEvent{
+		Address:    queryTestScAddress,
+		Identifier: "buy_ticket",
+		Fields: map[string]interface{}{
+			"caller":       encoderTestAddress,
+			"lottery_name": "demo",
+			"amount":       big.NewInt(1000),
+		},
+	}, decoded)
+
+	// all data inputs nested in a single item and a zero topic
+	decoded, err = decoder.DecodeEvent(&models.SCEvent{
+		Identifier: "determine_winner",
+		Topics:     []string{base64.StdEncoding.EncodeToString([]byte("winner")), ""},
+		Data:       []string{b64(t, "00000002"+"03e8"+"00000003"+"4b4c56")},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "winner", decoded.Identifier)
+	assert.Equal(t, map[string]interface{}{
+		"ticket": uint32(0),
+		"prize":  big.NewInt(1000),
+		"token":  "KLV",
+	}, decoded.Fields)
+
+	_, err = decoder.DecodeEvent(&models.SCEvent{Identifier: "unknown", Topics: []string{base64.StdEncoding.EncodeToString([]byte("unknown"))}})
+	assert.True(t, errors.Is(err, provider.ErrEventNotFound))
+
+	_, err = decoder.DecodeEvent(&models.SCEvent{Identifier: "buy_ticket", Topics: []string{base64.StdEncoding.EncodeToString([]byte("buy_ticket"))}})
+	assert.NotNil(t, err)
+}
+
+func Test_Decode_Event_By_Identifier(t *testing.T) {
+	decoder := newEventDecoder(t)
+
+	// the first topic is no event of the abi, every topic is an indexed input
+	decoded, err := decoder.DecodeEvent(&models.SCEvent{
+		Identifier: "buy_ticket",
+		Topics: []string{
+			b64(t, encoderTestAddressHex),
+			base64.StdEncoding.EncodeToString([]byte("demo")),
+		},
+		Data: []string{b64(t, "03e8")},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "buy_ticket", decoded.Identifier)
+	assert.Equal(t, map[string]interface{}{
+		"caller":       encoderTestAddress,
+		"lottery_name": "demo",
+		"amount":       big.NewInt(1000),
+	}, decoded.Fields)
+
+	decoded, err = decoder.DecodeEvent(&models.SCEvent{
+		Identifier: "winner",
+		Topics:     []string{b64(t, "07")},
+		Data:       []string{b64(t, "03e8"), base64.StdEncoding.EncodeToString([]byte("KLV"))},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(7), decoded.Fields["ticket"])
+
+	_, err = decoder.DecodeEvent(&models.SCEvent{Identifier: "buy_ticket", Topics: []string{b64(t, encoderTestAddressHex)}})
+	assert.EqualError(t, err, "event buy_ticket expects 2 indexed topics, got 1")
+}
+
+func Test_Decode_Transaction_Events(t *testing.T) {
+	decoder := newEventDecoder(t)
+
+	var tx models.TransactionAPI
+	err := json.Unmarshal([]byte(`{
+		"hash": "ab",
+		"receipts": [
+			{"type": 0, "from": "klv1", "value": 10},
+			{"address": "`+queryTestScAddress+`", "identifier": "buy_ticket", "topics": ["YnV5X3RpY2tldA==", "`+b64(t, encoderTestAddressHex)+`", "ZGVtbw=="], "data": "A+g="}
+		],
+		"logs": {"address": "`+queryTestScAddress+`", "events": [
+			{"address": "`+queryTestScAddress+`", "identifier": "transferValueOnly", "topics": ["AQ=="], "data": null},
+			{"address": "`+queryTestScAddress+`", "identifier": "winner", "topics": ["d2lubmVy", "AAAABQ=="], "data": ["A+g=", "S0xW"]}
+		]}
+	}`), &tx)
+	require.Nil(t, err)
+	require.NotNil(t, tx.Logs)
+	assert.Nil(t, tx.Logs.Events[0].Data)
+
+	events, err := decoder.DecodeTransactionEvents(&tx)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "winner", events[0].Identifier)
+	assert.Equal(t, uint32(5), events[0].Fields["ticket"])
+	assert.Equal(t, "KLV", events[0].Fields["token"])
+
+	assert.Equal(t, "buy_ticket", events[1].Identifier)
+	assert.Equal(t, big.NewInt(1000), events[1].Fields["amount"])
+
+	fromReceipts, err := decoder.DecodeReceiptEvents(tx.Receipts)
+	require.Nil(t, err)
+	assert.Equal(t, events[1:], fromReceipts)
+}
@@ -13,14 +13,24 @@ import (
 )
 
 func EncodeInput(args []string) (string, error) {
-	encodedArgs := ""
+	encoded, err := encodeArguments(args)
+	if err != nil {
+		return "", err
+	}
+
+	return encoded.String(), nil
+}
+
+// encodeArguments encodes each "type:value" argument to hex
+func encodeArguments(args []string) (EncodedArguments, error) {
+	encodedArgs := make(EncodedArguments, 0, len(args))
 	for _, arg := range args {
 		encoded, err := doEncode(arg)
 		if err != nil {
-			return "", fmt.Errorf("invalid item to encode `%s`", arg)
+			return nil, fmt.Errorf("invalid item to encode `%s`: %w", arg, err)
 		}
 
-		encodedArgs += "@" + encoded
+		encodedArgs = append(encodedArgs, encoded)
 	}
 
 	return encodedArgs, nil
@@ -28,6 +38,9 @@ func EncodeInput(args []string) (string, error) {
 
 func doEncode(arg string) (string, error) {
 	kv := strings.SplitN(arg, ":", 2)
+	if len(kv) != 2 {
+		return "", fmt.Errorf("missing type, expected `type:value`")
+	}
 
 	isOption := false
 	// check if it is an option argument
@@ -90,6 +103,8 @@ func encodeSingleValue(t, v string, isNested bool) (string, error) {
 		return encodeBoolean(v, isNested)
 	case "empty", "0", "e", "E":
 		return "", nil
+	case "raw", "hex": // already encoded values, e.g. from VMInputEncoder
+		return encodeRawHex(v)
 	default:
 		return "", fmt.Errorf("invalid encode type `%s`", t)
 	}
@@ -181,6 +196,14 @@ func encodeNestedUint(v, t string) (string, error) {
 	}
 }
 
+func encodeRawHex(v string) (string, error) {
+	if _, err := hex.DecodeString(v); err != nil {
+		return "", fmt.Errorf("invalid hex string `%s`", v)
+	}
+
+	return strings.ToLower(v), nil
+}
+
 func encodeAddress(v string) (string, error) {
 	address, err := address.NewAddress(v)
 	if err != nil {
@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+// DecodedArgument is a smart contract call argument, Type and Name are empty
+// for arguments not declared in the abi, which keep the hex value
+type DecodedArgument struct {
+	Name  string
+	Type  string
+	Value interface{}
+}
+
+// SCInvocation is a smart contract call or deploy decoded from the transaction data
+type SCInvocation struct {
+	SCType utils.SCType
+	// Address is the invoked contract, empty for deploys
+	Address string
+	// Function is the invoked endpoint, empty for deploys
+	Function string
+	// CallValue is filled when the contract parameter could be read
+	CallValue map[string]int64
+	Arguments []DecodedArgument
+	// RawArguments are the hex encoded arguments
+	RawArguments []string
+	// Code, VMType and Metadata are only set for deploys
+	Code     []byte
+	VMType   string
+	Metadata *SCMetadata
+}
+
+// Argument returns the value of the argument `name`
+func (inv *SCInvocation) Argument(name string) (interface{}, bool) {
+	for _, arg := range inv.Arguments {
+		if arg.Name == name {
+			return arg.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// DecodeInvocation decodes the smart contract contract of a transaction built by
+// InvokeSmartContract or DeploySmartContract, the contract
+// parameter tells how the data is laid out
+func (a *vmOutputData) DecodeInvocation(tx *proto.Transaction) (*SCInvocation, error) {
+	raw := tx.GetRawData()
+	if raw == nil {
+		return nil, fmt.Errorf("transaction without raw data")
+	}
+
+	var contract *proto.TXContract
+	for _, c := range raw.GetContract() {
+		if c.GetType() == proto.TXContract_SmartContractType {
+			contract = c
+			break
+		}
+	}
+	if contract == nil {
+		return nil, fmt.Errorf("transaction has no smart contract contract")
+	}
+
+	if len(raw.GetData()) == 0 {
+		return nil, fmt.Errorf("smart contract transaction without data")
+	}
+
+	if !strings.HasSuffix(contract.GetParameter().GetTypeUrl(), "SmartContract") {
+		return nil, fmt.Errorf("unexpected smart contract parameter `%s`", contract.GetParameter().GetTypeUrl())
+	}
+
+	scType, scAddress, callValue, err := parseSmartContractParameter(contract.GetParameter().GetValue())
+	if err != nil {
+		return nil, fmt.Errorf("invalid smart contract parameter: %w", err)
+	}
+
+	inv, err := a.DecodeInvocationData(scType, string(raw.GetData()[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	inv.Address = scAddress
+	inv.CallValue = callValue
+
+	return inv, nil
+}
+
+// DecodeInvocationAPI is DecodeInvocation for transactions returned by the API
+func (a *vmOutputData) DecodeInvocationAPI(tx *models.TransactionAPI) (*SCInvocation, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("nil transaction")
+	}
+
+	var contract *models.TXContractAPI
+	for _, c := range tx.Contracts {
+		if c != nil && c.Type == proto.TXContract_SmartContractType {
+			contract = c
+			break
+		}
+	}
+	if contract == nil {
+		return nil, fmt.Errorf("transaction has no smart contract contract")
+	}
+
+	if len(tx.Data) == 0 {
+		return nil, fmt.Errorf("smart contract transaction without data")
+	}
+
+	parameter, _ := contract.Parameter.(map[string]interface{})
+	scType, ok := parameterSCType(parameter)
+	if !ok {
+		return nil, fmt.Errorf("smart contract parameter without type")
+	}
+
+	inv, err := a.DecodeInvocationData(scType, a.apiDataItem(scType, tx.Data[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	inv.Address, _ = parameter["address"].(string)
+	if values, ok := parameter["callValue"].(map[string]interface{}); ok {
+		inv.CallValue = make(map[string]int64, len(values))
+		for kda, value := range values {
+			if amount, ok := value.(float64); ok {
+				inv.CallValue[kda] = int64(amount)
+			}
+		}
+	}
+
+	return inv, nil
+}
+
+// DecodeInvocationData decodes the first data item of a smart contract transaction of type `scType`,
+// `func@args` for invokes and `wasm@vmType@metadata@args` for deploys
+func (a *vmOutputData) DecodeInvocationData(scType utils.SCType, data string) (*SCInvocation, error) {
+	if !a.AbiLoaded {
+		return nil, fmt.Errorf("before decode any value load your abi with `LoadAbi`")
+	}
+
+	parts := strings.Split(data, "@")
+	inv := &SCInvocation{SCType: scType}
+
+	var inputs []input
+	switch scType {
+	case utils.SmartContractDeploy:
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("invalid deploy data, expected wasm@vmType@metadata")
+		}
+
+		code, err := hex.DecodeString(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid deploy code: %w", err)
+		}
+
+		metadataBytes, err := hex.DecodeString(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid deploy metadata: %w", err)
+		}
+
+		metadata, err := SCMetadataFromBytes(metadataBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		inv.Code = code
+		inv.VMType = parts[1]
+		inv.Metadata = metadata
+		inv.RawArguments = parts[3:]
+		inputs = a.Constructor.Inputs
+	case utils.SmartContractInvoke:
+		inv.Function = parts[0]
+		inv.RawArguments = parts[1:]
+
+		index, err := a.findEndpoint(inv.Function)
+		if err != nil {
+			return nil, err
+		}
+		inputs = a.Endpoints[*index].Inputs
+	default:
+		return nil, fmt.Errorf("invalid smart contract type %d", scType)
+	}
+
+	args, err := a.decodeArguments(inputs, inv.RawArguments)
+	if err != nil {
+		name := inv.Function
+		if scType != utils.SmartContractInvoke {
+			name = "constructor"
+		}
+		return nil, fmt.Errorf("error decoding arguments of %s: %w", name, err)
+	}
+	inv.Arguments = args
+
+	return inv, nil
+}
+
+// decodeArguments reverses vmInputEncoder.encodeInputs
+func (a *vmOutputData) decodeArguments(inputs []input, rawArgs []string) ([]DecodedArgument, error) {
+	args := make([]DecodedArgument, 0, len(inputs))
+	next := 0
+
+	decodeOne := func(valueType string) (interface{}, error) {
+		arg := emptyAsZero(rawArgs[next], valueType)
+		next++
+		return a.doDecode(&arg, valueType, 0)
+	}
+
+	for _, in := range inputs {
+		wrapper, valueType := utils.SplitTypes(in.Type)
+
+		var value interface{}
+		var err error
+
+		switch wrapper {
+		case abiOptional:
+			if next < len(rawArgs) {
+				value, err = decodeOne(valueType)
+			}
+		case utils.Variadic:
+			items := make([]interface{}, 0, len(rawArgs)-next)
+			for next < len(rawArgs) && err == nil {
+				var item interface{}
+				item, err = decodeOne(valueType)
+				items = append(items, item)
+			}
+			value = items
+		case abiMulti:
+			types := utils.SplitTupleTypes(valueType)
+			if len(rawArgs)-next < len(types) {
+				return nil, fmt.Errorf("missing values of argument %s", in.Name)
+			}
+
+			items := make([]interface{}, 0, len(types))
+			for _, t := range types {
+				var item interface{}
+				if item, err = decodeOne(t); err != nil {
+					break
+				}
+				items = append(items, item)
+			}
+			value = items
+		default:
+			if next >= len(rawArgs) {
+				return nil, fmt.Errorf("missing argument %s", in.Name)
+			}
+			value, err = decodeOne(in.Type)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("argument %s: %w", in.Name, err)
+		}
+
+		args = append(args, DecodedArgument{Name: in.Name, Type: in.Type, Value: value})
+	}
+
+	for ; next < len(rawArgs); next++ {
+		args = append(args, DecodedArgument{Value: rawArgs[next]})
+	}
+
+	return args, nil
+}
+
+// parseSmartContractParameter reads type, address and call value of the SmartContract contract proto
+func parseSmartContractParameter(b []byte) (utils.SCType, string, map[string]int64, error) {
+	var scType utils.SCType
+	var scAddress string
+	callValue := make(map[string]int64)
+
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return 0, "", nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch {
+		case num == scContractTypeField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return 0, "", nil, protowire.ParseError(n)
+			}
+			scType = utils.SCType(v)
+			b = b[n:]
+		case num == scContractAddressField && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, "", nil, protowire.ParseError(n)
+			}
+			addr, err := address.NewAddressFromBytes(v)
+			if err != nil {
+				return 0, "", nil, err
+			}
+			scAddress = addr.Bech32()
+			b = b[n:]
+		case num == scContractCallValueField && typ == protowire.BytesType:
+			entry, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, "", nil, protowire.ParseError(n)
+			}
+			kda, amount, err := parseCallValueEntry(entry)
+			if err != nil {
+				return 0, "", nil, err
+			}
+			callValue[kda] = amount
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return 0, "", nil, protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+
+	return scType, scAddress, callValue, nil
+}
+
+func parseCallValueEntry(b []byte) (string, int64, error) {
+	var kda string
+	var amount int64
+
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return "", 0, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch {
+		case num == mapEntryKeyField && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return "", 0, protowire.ParseError(n)
+			}
+			kda = string(v)
+			b = b[n:]
+		case num == mapEntryValueField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return "", 0, protowire.ParseError(n)
+			}
+			amount = int64(v)
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return "", 0, protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+
+	return kda, amount, nil
+}
+
+func parameterSCType(parameter map[string]interface{}) (utils.SCType, bool) {
+	for _, key := range []string{"scType", "type"} {
+		switch v := parameter[key].(type) {
+		case float64:
+			return utils.SCType(v), true
+		case string:
+			switch lower := strings.ToLower(v); {
+			case strings.Contains(lower, "deploy"):
+				return utils.SmartContractDeploy, true
+			}
+			return utils.SmartContractInvoke, true
+		}
+	}
+
+	return 0, false
+}
+
+// apiDataItem returns the data as sent, the API may return it base64 encoded. The decoded
+// text is only taken when it is valid UTF-8 and names a known endpoint or has the deploy layout
+func (a *vmOutputData) apiDataItem(scType utils.SCType, data string) string {
+	if a.isInvocationData(scType, data) {
+		return data
+	}
+
+	if b, err := base64.StdEncoding.DecodeString(data); err == nil && utf8.Valid(b) && a.isInvocationData(scType, string(b)) {
+		return string(b)
+	}
+
+	return data
+}
+
+// isInvocationData reports whether data starts with an endpoint of the abi for invokes,
+// or has the `wasm@vmType@metadata` layout for deploys
+func (a *vmOutputData) isInvocationData(scType utils.SCType, data string) bool {
+	parts := strings.Split(data, "@")
+
+	switch scType {
+	case utils.SmartContractDeploy:
+		if len(parts) < 3 {
+			return false
+		}
+
+		_, codeErr := hex.DecodeString(parts[0])
+		metadata, metadataErr := hex.DecodeString(parts[2])
+
+		return codeErr == nil && metadataErr == nil && len(metadata) == utils.LengthOfCodeMetadata
+	case utils.SmartContractInvoke:
+		_, err := a.findEndpoint(parts[0])
+		return err == nil
+	}
+
+	return false
+}
@@ -0,0 +1,219 @@
+package provider_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+func newLotteryDecoder(t *testing.T) provider.VMOutputData {
+	jsonAbi, err := os.Open("../cmd/demo/smartContracts/scFiles/lottery-kda.abi.json")
+	require.Nil(t, err)
+	defer jsonAbi.Close()
+
+	decoder := provider.NewVMOutputHandler()
+	require.Nil(t, decoder.LoadAbi(jsonAbi))
+
+	return decoder
+}
+
+func smartContractParameter(t *testing.T, scType utils.SCType, scAddress string, callValue map[string]int64) *anypb.Any {
+	addr, err := address.NewAddress(scAddress)
+	require.Nil(t, err)
+
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(scType))
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, addr.Bytes())
+	for kda, amount := range callValue {
+		var entry []byte
+		entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+		entry = protowire.AppendString(entry, kda)
+		entry = protowire.AppendTag(entry, 2, protowire.VarintType)
+		entry = protowire.AppendVarint(entry, uint64(amount))
+
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+
+	return &anypb.Any{TypeUrl: "type.googleapis.com/proto.SmartContract", Value: b}
+}
+
+func Test_Decode_Invocation(t *testing.T) {
+	decoder := newLotteryDecoder(t)
+
+	encoder := provider.NewVMInputEncoder()
+	jsonAbi, err := os.Open("../cmd/demo/smartContracts/scFiles/lottery-kda.abi.json")
+	require.Nil(t, err)
+	defer jsonAbi.Close()
+	require.Nil(t, encoder.LoadAbi(jsonAbi))
+
+	args, err := encoder.EncodeEndpoint("start", "demo", "KLV", big.NewInt(1000), nil, uint64(100), nil, nil, []string{encoderTestAddress}, 10)
+	require.Nil(t, err)
+
+	tx := &proto.Transaction{RawData: &proto.Transaction_Raw{
+		Contract: []*proto.TXContract{{
+			Type:      proto.TXContract_SmartContractType,
+			Parameter: smartContractParameter(t, utils.SmartContractInvoke, queryTestScAddress, map[string]int64{"KLV": 5}),
+		}},
+		Data: [][]byte{[]byte("start" + args.String())},
+	}}
+
+	inv, err := decoder.DecodeInvocation(tx)
+	require.Nil(t, err)
+
+	assert.Equal(t, utils.SmartContractInvoke, inv.SCType)
+	assert.Equal(t, queryTestScAddress, inv.Address)
+	assert.Equal(t, "start", inv.Function)
+	assert.Equal(t, map[string]int64{"KLV": 5}, inv.CallValue)
+	assert.Equal(t, []string(args), inv.RawArguments)
+	require.Len(t, inv.Arguments, 9)
+
+	assert.Equal(t, provider.DecodedArgument{Name: "lottery_name", Type: "bytes", Value: "demo"}, inv.Arguments[0])
+	assert.Equal(t, big.NewInt(1000), inv.Arguments[2].Value)
+	assert.Nil(t, inv.Arguments[3].Value)
+	assert.Equal(t, uint64(100), inv.Arguments[4].Value)
+	assert.Equal(t, []interface{}{encoderTestAddress}, inv.Arguments[7].Value)
+
+	burn, exists := inv.Argument("opt_burn_percentage")
+	require.True(t, exists)
+	assert.Equal(t, big.NewInt(10), burn)
+
+	// the contract parameter tells the layout of the data
+	tx.RawData.Contract[0].Parameter = &anypb.Any{TypeUrl: "testutil/json", Value: []byte(`{}`)}
+	_, err = decoder.DecodeInvocation(tx)
+	assert.NotNil(t, err)
+}
+
+func Test_Decode_Invocation_Type_From_Parameter(t *testing.T) {
+	decoder := newLotteryDecoder(t)
+
+	metadata := provider.SCMetadata{Upgradeable: true, Readable: true}
+	data := "0061736d01000000@0500@" + hex.EncodeToString(metadata.ToBytes())
+
+	tx := &proto.Transaction{RawData: &proto.Transaction_Raw{
+		Contract: []*proto.TXContract{{
+			Type:      proto.TXContract_SmartContractType,
+			Parameter: smartContractParameter(t, utils.SmartContractDeploy, queryTestScAddress, nil),
+		}},
+		Data: [][]byte{[]byte(data)},
+	}}
+
+	inv, err := decoder.DecodeInvocation(tx)
+	require.Nil(t, err)
+	assert.Equal(t, utils.SmartContractDeploy, inv.SCType)
+	assert.Equal(t, queryTestScAddress, inv.Address)
+	assert.Equal(t, &metadata, inv.Metadata)
+
+	// data shaped like a deploy is an invoke when the parameter says so
+	tx.RawData.Contract[0].Parameter = smartContractParameter(t, utils.SmartContractInvoke, queryTestScAddress, nil)
+	_, err = decoder.DecodeInvocation(tx)
+	assert.ErrorContains(t, err, "0061736d01000000")
+
+	// types the sdk doesn't know are not guessed from the data
+	tx.RawData.Contract[0].Parameter = smartContractParameter(t, utils.SCType(2), queryTestScAddress, nil)
+	_, err = decoder.DecodeInvocation(tx)
+	assert.ErrorContains(t, err, "invalid smart contract type 2")
+
+	_, err = decoder.DecodeInvocationData(utils.SCType(7), data)
+	assert.NotNil(t, err)
+}
+
+func Test_Decode_Invocation_Deploy(t *testing.T) {
+	decoder := newLotteryDecoder(t)
+
+	metadata := provider.SCMetadata{Payable: true, Upgradeable: true, Readable: true}
+	data := "0061736d01000000@0500@" + strings.ToUpper(hex.EncodeToString(metadata.ToBytes()))
+
+	inv, err := decoder.DecodeInvocationData(utils.SmartContractDeploy, data)
+	require.Nil(t, err)
+
+	assert.Equal(t, utils.SmartContractDeploy, inv.SCType)
+	assert.Equal(t, "0500", inv.VMType)
+	assert.Equal(t, []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}, inv.Code)
+	assert.Equal(t, &metadata, inv.Metadata)
+	assert.Empty(t, inv.Arguments)
+
+	_, err = provider.SCMetadataFromBytes([]byte{1})
+	assert.NotNil(t, err)
+}
+
+func Test_Decode_Invocation_API(t *testing.T) {
+	decoder := newLotteryDecoder(t)
+
+	tx := &models.TransactionAPI{
+		Data: []string{base64.StdEncoding.EncodeToString([]byte("buy_ticket@64656d6f"))},
+		Contracts: []*models.TXContractAPI{{
+			Type: proto.TXContract_SmartContractType,
+			Parameter: map[string]interface{}{
+				"type":      "SCInvoke",
+				"address":   queryTestScAddress,
+				"callValue": map[string]interface{}{"KLV": float64(10000000)},
+			},
+		}},
+	}
+
+	inv, err := decoder.DecodeInvocationAPI(tx)
+	require.Nil(t, err)
+
+	assert.Equal(t, "buy_ticket", inv.Function)
+	assert.Equal(t, queryTestScAddress, inv.Address)
+	assert.Equal(t, map[string]int64{"KLV": 10000000}, inv.CallValue)
+	assert.Equal(t, []provider.DecodedArgument{{Name: "lottery_name", Type: "bytes", Value: "demo"}}, inv.Arguments)
+
+	tx.Data = []string{"unknown@01"}
+	_, err = decoder.DecodeInvocationAPI(tx)
+	assert.NotNil(t, err)
+
+	tx.Data = []string{"buy_ticket"}
+	_, err = decoder.DecodeInvocationAPI(tx)
+	assert.NotNil(t, err)
+}
+
+func Test_Decode_Invocation_API_Without_Arguments(t *testing.T) {
+	decoder := provider.NewVMOutputHandler()
+	require.Nil(t, decoder.LoadAbi(strings.NewReader(`{
+		"name": "Rewards",
+		"constructor": {"inputs": [], "outputs": []},
+		"endpoints": [{"name": "claim", "mutability": "mutable", "inputs": [], "outputs": []}],
+		"types": {}
+	}`)))
+
+	// data of a `claim` call as returned by the API, base64 encoded without any "@"
+	var tx models.TransactionAPI
+	require.Nil(t, json.Unmarshal([]byte(`{
+		"data": ["Y2xhaW0="],
+		"contract": [{
+			"type": 63,
+			"parameter": {"type": "SCInvoke", "address": "`+queryTestScAddress+`"}
+		}]
+	}`), &tx))
+
+	inv, err := decoder.DecodeInvocationAPI(&tx)
+	require.Nil(t, err)
+	assert.Equal(t, "claim", inv.Function)
+	assert.Equal(t, queryTestScAddress, inv.Address)
+	assert.Empty(t, inv.Arguments)
+
+	// data already sent as text is kept
+	tx.Data = []string{"claim"}
+	inv, err = decoder.DecodeInvocationAPI(&tx)
+	require.Nil(t, err)
+	assert.Equal(t, "claim", inv.Function)
+}
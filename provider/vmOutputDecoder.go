@@ -12,6 +12,8 @@ import (
 	"strings"
 
 	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
 	"github.com/klever-io/klever-go-sdk/provider/utils"
 )
 
@@ -19,10 +21,18 @@ type output struct {
 	Type string `json:"type"`
 }
 
+type input struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	MultiArg bool   `json:"multi_arg,omitempty"`
+}
+
 type endpoint struct {
-	Name       string   `json:"name"`
-	Mutability string   `json:"mutability"`
-	Outputs    []output `json:"outputs"`
+	Name            string   `json:"name"`
+	Mutability      string   `json:"mutability"`
+	PayableInTokens []string `json:"payableInTokens,omitempty"`
+	Inputs          []input  `json:"inputs"`
+	Outputs         []output `json:"outputs"`
 }
 
 type field struct {
@@ -42,15 +52,35 @@ type typeInfos struct {
 	EnumVariants []enumVariant `json:"variants,omitempty"`
 }
 
+type eventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed,omitempty"`
+}
+
+type event struct {
+	Identifier string       `json:"identifier"`
+	Inputs     []eventInput `json:"inputs"`
+}
+
 type vmOutputData struct {
-	Types     map[string]typeInfos `json:"types"`
-	Endpoints []endpoint           `json:"endpoints"`
-	AbiLoaded bool
+	Name        string               `json:"name"`
+	Constructor endpoint             `json:"constructor"`
+	Types       map[string]typeInfos `json:"types"`
+	Endpoints   []endpoint           `json:"endpoints"`
+	Events      []event              `json:"events"`
+	AbiLoaded   bool
 }
 
 type VMOutputData interface {
 	DecodeHex(endpoint string, hex []string) (interface{}, error)
 	DecodeQuery(endpoint string, base64 []string) (interface{}, error)
+	DecodeEvent(event *models.SCEvent) (*DecodedEvent, error)
+	DecodeTransactionEvents(tx *models.TransactionAPI) ([]*DecodedEvent, error)
+	DecodeReceiptEvents(receipts []map[string]interface{}) ([]*DecodedEvent, error)
+	DecodeInvocation(tx *proto.Transaction) (*SCInvocation, error)
+	DecodeInvocationAPI(tx *models.TransactionAPI) (*SCInvocation, error)
+	DecodeInvocationData(scType utils.SCType, data string) (*SCInvocation, error)
 	LoadAbi(r io.Reader) error
 }
 
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider/utils/http_options/options"
+)
+
+const scQueryReturnCodeOk = "ok"
+
+// QuerySmartContract calls the readonly endpoint `funcName` of `scAddress`, `arguments` use the same
+// "type:value" format of InvokeSmartContract. A return code other than Ok is returned as *SCQueryError
+func (kc *kleverChain) QuerySmartContract(
+	ctx context.Context,
+	scAddress string,
+	funcName string,
+	arguments ...string,
+) (*models.SCQueryResult, error) {
+	args, err := encodeArguments(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(models.SCQueryRequest{
+		ScAddress: scAddress,
+		FuncName:  funcName,
+		Args:      args,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		Data struct {
+			Data *models.SCQueryResult `json:"data"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+
+	err = kc.httpClient.Post(ctx, fmt.Sprintf("%s/vm/query", kc.nodeUri(ctx)), string(body), nil, &result, options.NewIdempotent(true))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Error) != 0 {
+		return nil, fmt.Errorf("error querying %s of %s: %s", funcName, scAddress, result.Error)
+	}
+
+	queryResult := result.Data.Data
+	if queryResult == nil {
+		return nil, fmt.Errorf("empty response querying %s of %s", funcName, scAddress)
+	}
+
+	if !strings.EqualFold(queryResult.ReturnCode, scQueryReturnCodeOk) {
+		return queryResult, &SCQueryError{
+			ScAddress:     scAddress,
+			FuncName:      funcName,
+			ReturnCode:    queryResult.ReturnCode,
+			ReturnMessage: queryResult.ReturnMessage,
+		}
+	}
+
+	return queryResult, nil
+}
+
+// QuerySmartContractDecoded calls QuerySmartContract and decodes the returned data with `decoder`,
+// which must have the contract ABI loaded
+func (kc *kleverChain) QuerySmartContractDecoded(
+	ctx context.Context,
+	decoder VMOutputData,
+	scAddress string,
+	funcName string,
+	arguments ...string,
+) (interface{}, error) {
+	result, err := kc.QuerySmartContract(ctx, scAddress, funcName, arguments...)
+	if err != nil {
+		return nil, err
+	}
+
+	return decoder.DecodeQuery(funcName, result.ReturnData)
+}
@@ -0,0 +1,99 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/provider"
+)
+
+const queryTestScAddress = "klv1qqqqqqqqqqqqqpgq4f6qkvv34kr50w5lucdn6z0238r7m96nhtxspjw22t"
+
+func newQueryTestKleverChain(t *testing.T, result models.SCQueryResult, request *models.SCQueryRequest) provider.KleverChain {
+	return newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/vm/query", r.URL.Path)
+		require.Nil(t, json.NewDecoder(r.Body).Decode(request))
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"data": result},
+			"code": "successful",
+		})
+	})
+}
+
+func Test_QuerySmartContract(t *testing.T) {
+	var request models.SCQueryRequest
+	kc := newQueryTestKleverChain(t, models.SCQueryResult{
+		ReturnData: []string{"AQ=="},
+		ReturnCode: "Ok",
+	}, &request)
+
+	result, err := kc.QuerySmartContract(context.Background(), queryTestScAddress, "status", "String:demo", "u32:5", "hex:00ff")
+	require.Nil(t, err)
+
+	assert.Equal(t, models.SCQueryRequest{
+		ScAddress: queryTestScAddress,
+		FuncName:  "status",
+		Args:      []string{"64656d6f", "05", "00ff"},
+	}, request)
+
+	hexData, err := result.HexReturnData()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"01"}, hexData)
+
+	jsonAbi, err := os.Open("../cmd/demo/smartContracts/scFiles/lottery-kda.abi.json")
+	require.Nil(t, err)
+	defer jsonAbi.Close()
+
+	decoder := kc.NewScOutputDecoder()
+	require.Nil(t, decoder.LoadAbi(jsonAbi))
+
+	decoded, err := kc.QuerySmartContractDecoded(context.Background(), decoder, queryTestScAddress, "status", "String:demo")
+	require.Nil(t, err)
+	assert.Equal(t, "Running", decoded)
+}
+
+func Test_QuerySmartContract_ReturnCodeError(t *testing.T) {
+	var request models.SCQueryRequest
+	kc := newQueryTestKleverChain(t, models.SCQueryResult{
+		ReturnCode:    "user error",
+		ReturnMessage: "lottery not found",
+	}, &request)
+
+	result, err := kc.QuerySmartContract(context.Background(), queryTestScAddress, "status")
+	require.NotNil(t, err)
+	require.NotNil(t, result)
+
+	var queryErr *provider.SCQueryError
+	require.True(t, errors.As(err, &queryErr))
+	assert.Equal(t, "user error", queryErr.ReturnCode)
+	assert.Equal(t, "lottery not found", queryErr.ReturnMessage)
+	assert.True(t, errors.Is(err, provider.ErrTXVMUserError))
+	assert.False(t, errors.Is(err, provider.ErrTXVMOutOfGas))
+	assert.Equal(t, "query status of "+queryTestScAddress+" failed with return code user error: lottery not found", err.Error())
+
+	_, err = kc.QuerySmartContract(context.Background(), queryTestScAddress, "status", "u8:256")
+	assert.NotNil(t, err)
+}
+
+func Test_QuerySmartContract_ArgumentWithoutType(t *testing.T) {
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("query sent with an invalid argument")
+	})
+
+	_, err := kc.QuerySmartContract(context.Background(), queryTestScAddress, "status", "demo")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid item to encode `demo`")
+
+	_, err = provider.EncodeInput([]string{"u32:5", "5"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+const (
+	TXStatusSuccess = "success"
+	TXStatusFail    = "fail"
+
+	defaultPollInterval    = 500 * time.Millisecond
+	defaultMaxPollInterval = 8 * time.Second
+)
+
+func (kc *kleverChain) GetBlockHeightWithContext(ctx context.Context) (uint64, error) {
+	result := struct {
+		Data struct {
+			Metrics struct {
+				Nonce uint64 `json:"klv_nonce"`
+			} `json:"metrics"`
+		} `json:"data"`
+	}{}
+
+	err := kc.httpClient.Get(ctx, fmt.Sprintf("%s/node/status", kc.nodeUri(ctx)), &result)
+
+	return result.Data.Metrics.Nonce, err
+}
+
+func (kc *kleverChain) GetBlockHeight() (uint64, error) {
+	return kc.GetBlockHeightWithContext(context.Background())
+}
+
+// WaitForTransaction polls the API until the transaction is included in a block with the
+// required confirmations. A transaction not yet indexed is considered pending and connection
+// errors, rate limits and 5xx responses are polled again until ctx is done. When its
+// execution failed a *TransactionFailedError is returned along with the transaction.
+func (kc *kleverChain) WaitForTransaction(ctx context.Context, hash string, opts *models.WaitOptions) (*models.TransactionAPI, error) {
+	if opts == nil {
+		opts = &models.WaitOptions{}
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	maxInterval := opts.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxPollInterval
+	}
+
+	for {
+		tx, done, err := kc.checkTransaction(ctx, hash, opts.Confirmations)
+		if done || (err != nil && (ctx.Err() != nil || !utils.IsRetryable(err))) {
+			return tx, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return tx, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+func (kc *kleverChain) checkTransaction(ctx context.Context, hash string, confirmations uint64) (*models.TransactionAPI, bool, error) {
+	tx, err := kc.GetTransactionWithContext(ctx, hash)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	switch strings.ToLower(tx.Status) {
+	case TXStatusSuccess:
+	case TXStatusFail:
+		return tx, true, newTransactionFailedError(tx)
+	default:
+		return tx, false, nil
+	}
+
+	if len(tx.ResultCode) != 0 && tx.ResultCode != proto.Transaction_Ok.String() {
+		return tx, true, newTransactionFailedError(tx)
+	}
+
+	if confirmations == 0 {
+		return tx, true, nil
+	}
+
+	height, err := kc.GetBlockHeightWithContext(ctx)
+	if err != nil {
+		return tx, false, err
+	}
+
+	return tx, height >= tx.BlockNum+confirmations, nil
+}
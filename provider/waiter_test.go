@@ -0,0 +1,135 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+)
+
+func newTestKleverChain(t *testing.T, handler http.HandlerFunc) provider.KleverChain {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	kc, err := provider.NewKleverChain(
+		network.NewNetworkConfigCustom(server.URL, server.URL, server.URL),
+		utils.NewHttpClient(time.Second),
+	)
+	require.Nil(t, err)
+
+	return kc
+}
+
+func Test_WaitForTransaction_Confirmations(t *testing.T) {
+	var polls atomic.Int32
+	var height atomic.Uint64
+	height.Store(10)
+
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/node/status":
+			fmt.Fprintf(w, `{"data":{"metrics":{"klv_nonce":%d}}}`, height.Add(1))
+		case strings.HasPrefix(r.URL.Path, "/transaction/"):
+			if polls.Add(1) == 1 {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"data":null,"error":"transaction not found","code":"internal_issue"}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":{"transaction":{"hash":"abc","blockNum":10,"status":"success","resultCode":"Ok"}}}`)
+		}
+	})
+
+	tx, err := kc.WaitForTransaction(context.Background(), "abc", &models.WaitOptions{
+		PollInterval:  time.Millisecond,
+		Confirmations: 3,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "abc", tx.Hash)
+	assert.GreaterOrEqual(t, height.Load(), uint64(13))
+}
+
+func Test_WaitForTransaction_Failed(t *testing.T) {
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"transaction":{"hash":"abc","blockNum":10,"status":"fail","resultCode":"OutOfFunds"}}}`)
+	})
+
+	tx, err := kc.WaitForTransaction(context.Background(), "abc", nil)
+	require.NotNil(t, tx)
+
+	var failed *provider.TransactionFailedError
+	require.True(t, errors.As(err, &failed))
+	assert.Equal(t, proto.Transaction_OutOfFunds, failed.ResultCode)
+	assert.Equal(t, "abc", failed.Hash)
+}
+
+func Test_WaitForTransaction_ContextDone(t *testing.T) {
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":"transaction not found"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := kc.WaitForTransaction(ctx, "abc", &models.WaitOptions{PollInterval: time.Millisecond})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func Test_WaitForTransaction_TransientErrors(t *testing.T) {
+	var polls atomic.Int32
+	var statusCalls atomic.Int32
+
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/node/status":
+			if statusCalls.Add(1) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			fmt.Fprint(w, `{"data":{"metrics":{"klv_nonce":20}}}`)
+		case strings.HasPrefix(r.URL.Path, "/transaction/"):
+			if polls.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprint(w, `{"data":null,"error":"service unavailable","code":"internal_issue"}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":{"transaction":{"hash":"abc","blockNum":10,"status":"success","resultCode":"Ok"}}}`)
+		}
+	})
+
+	tx, err := kc.WaitForTransaction(context.Background(), "abc", &models.WaitOptions{
+		PollInterval:  time.Millisecond,
+		Confirmations: 1,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "abc", tx.Hash)
+	assert.Equal(t, int32(3), polls.Load())
+	assert.Equal(t, int32(2), statusCalls.Load())
+}
+
+func Test_WaitForTransaction_PermanentError(t *testing.T) {
+	var polls atomic.Int32
+	kc := newTestKleverChain(t, func(w http.ResponseWriter, r *http.Request) {
+		polls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"data":null,"error":"invalid hash","code":"bad_request"}`)
+	})
+
+	_, err := kc.WaitForTransaction(context.Background(), "abc", &models.WaitOptions{PollInterval: time.Millisecond})
+	assert.True(t, errors.Is(err, utils.ErrBadRequest))
+	assert.Equal(t, int32(1), polls.Load())
+}
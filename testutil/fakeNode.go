@@ -0,0 +1,612 @@
+// Package testutil provides an in-process fake of the Klever node and API,
+// so code depending on provider.KleverChain can be tested offline
+package testutil
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/klever-io/klever-go-sdk/core"
+	"github.com/klever-io/klever-go-sdk/core/address"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/network"
+)
+
+// JSONContractTypeURL is the type url of contract parameters built by the fake node,
+// the parameter value is the JSON of the contract request
+const JSONContractTypeURL = "testutil/json"
+
+// FakeNode serves the node and API routes used by provider.KleverChain from memory.
+// Transfers, freezes and asset creation are applied on broadcast, other contracts
+// are accepted and only increment the sender nonce.
+type FakeNode struct {
+	// ChainID, KAppFee and BandwidthFee are used on transactions built by /transaction/send,
+	// fees are charged in KLV on broadcast
+	ChainID      string
+	KAppFee      int64
+	BandwidthFee int64
+
+	server  *httptest.Server
+	builder provider.OfflineBuilder
+
+	mut          sync.Mutex
+	accounts     map[string]*models.Account
+	assets       map[string]*proto.KDAData
+	transactions map[string]*models.TransactionAPI
+	blockNum     uint64
+}
+
+// NewFakeNode starts a fake node, call Close when done
+func NewFakeNode() (*FakeNode, error) {
+	builder, err := provider.NewOfflineBuilder()
+	if err != nil {
+		return nil, err
+	}
+
+	for contractType := range proto.TXContract_ContractType_name {
+		builder.RegisterContractEncoder(proto.TXContract_ContractType(contractType), encodeJSONContract)
+	}
+
+	fn := &FakeNode{
+		ChainID:      "420",
+		builder:      builder,
+		accounts:     make(map[string]*models.Account),
+		assets:       make(map[string]*proto.KDAData),
+		transactions: make(map[string]*models.TransactionAPI),
+	}
+
+	fn.assets[core.KLV] = &proto.KDAData{ID: []byte(core.KLV), Ticker: []byte(core.KLV), Name: []byte("Klever"), Precision: 6}
+	fn.assets[core.KFI] = &proto.KDAData{ID: []byte(core.KFI), Ticker: []byte(core.KFI), Name: []byte("Klever Finance"), Precision: 6}
+
+	fn.server = httptest.NewServer(fn.routes())
+
+	return fn, nil
+}
+
+func encodeJSONContract(contract interface{}) (*anypb.Any, error) {
+	data, err := json.Marshal(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &anypb.Any{TypeUrl: JSONContractTypeURL, Value: data}, nil
+}
+
+// URL is used as node and API uri
+func (fn *FakeNode) URL() string {
+	return fn.server.URL
+}
+
+// NetworkConfig returns a custom network pointing node and API to the fake node
+func (fn *FakeNode) NetworkConfig() network.NetworkConfig {
+	return network.NewNetworkConfigCustom(fn.server.URL, fn.server.URL, fn.server.URL)
+}
+
+func (fn *FakeNode) Close() {
+	fn.server.Close()
+}
+
+func (fn *FakeNode) account(addr string) *models.Account {
+	acc, exists := fn.accounts[addr]
+	if !exists {
+		acc = &models.Account{
+			AccountInfo: &models.AccountInfo{Address: addr},
+			Assets:      make(map[string]*models.AccountKDA),
+		}
+		fn.accounts[addr] = acc
+	}
+
+	return acc
+}
+
+func (fn *FakeNode) accountKDA(acc *models.Account, kda string) *models.AccountKDA {
+	accKDA, exists := acc.Assets[kda]
+	if !exists {
+		accKDA = &models.AccountKDA{AccountAddress: acc.Address, AssetID: kda}
+		if asset, ok := fn.assets[kda]; ok {
+			accKDA.AssetName = string(asset.Name)
+			accKDA.AssetType = asset.AssetType
+			accKDA.Precision = asset.Precision
+		}
+		acc.Assets[kda] = accKDA
+	}
+
+	return accKDA
+}
+
+// SetBalance sets the available balance of an account, KLV is kept in the account balance
+func (fn *FakeNode) SetBalance(addr string, kda string, amount int64) {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	acc := fn.account(addr)
+	if kda == core.KLV || len(kda) == 0 {
+		acc.Balance = amount
+		return
+	}
+
+	fn.accountKDA(acc, kda).Balance = amount
+}
+
+// Balance returns the available balance of an account
+func (fn *FakeNode) Balance(addr string, kda string) int64 {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	acc := fn.account(addr)
+	if kda == core.KLV || len(kda) == 0 {
+		return acc.Balance
+	}
+
+	return fn.accountKDA(acc, kda).Balance
+}
+
+// FrozenBalance returns the frozen balance of an account
+func (fn *FakeNode) FrozenBalance(addr string, kda string) int64 {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	acc := fn.account(addr)
+	if kda == core.KLV || len(kda) == 0 {
+		return acc.FrozenBalance
+	}
+
+	return fn.accountKDA(acc, kda).FrozenBalance
+}
+
+func (fn *FakeNode) SetNonce(addr string, nonce uint64) {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	fn.account(addr).Nonce = nonce
+}
+
+func (fn *FakeNode) Nonce(addr string) uint64 {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	return fn.account(addr).Nonce
+}
+
+// AddAsset registers an asset served by /asset/{id}
+func (fn *FakeNode) AddAsset(asset *proto.KDAData) {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	fn.assets[string(asset.ID)] = asset
+}
+
+// Asset returns an asset by ID, nil if not found
+func (fn *FakeNode) Asset(assetID string) *proto.KDAData {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	return fn.assets[assetID]
+}
+
+func (fn *FakeNode) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /address/{address}", fn.handleAccount)
+	mux.HandleFunc("GET /account/{address}", fn.handleAccount)
+	mux.HandleFunc("GET /address/{address}/allowance", fn.handleAllowance)
+	mux.HandleFunc("GET /asset/{id}", fn.handleAsset)
+	mux.HandleFunc("GET /node/status", fn.handleStatus)
+	mux.HandleFunc("POST /transaction/send", fn.handleSend)
+	mux.HandleFunc("POST /transaction/decode", fn.handleDecode)
+	mux.HandleFunc("POST /transaction/broadcast", fn.handleBroadcast)
+	mux.HandleFunc("GET /transaction/{hash}", fn.handleTransaction)
+
+	return mux
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":  data,
+		"error": "",
+		"code":  "successful",
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, code string, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":  nil,
+		"error": err.Error(),
+		"code":  code,
+	})
+}
+
+func (fn *FakeNode) handleAccount(w http.ResponseWriter, r *http.Request) {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	writeData(w, map[string]interface{}{"account": fn.account(r.PathValue("address"))})
+}
+
+func (fn *FakeNode) handleAllowance(w http.ResponseWriter, r *http.Request) {
+	writeData(w, models.AccountAllowance{})
+}
+
+func (fn *FakeNode) handleAsset(w http.ResponseWriter, r *http.Request) {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	asset, exists := fn.assets[r.PathValue("id")]
+	if !exists {
+		writeError(w, http.StatusNotFound, "internal_issue", fmt.Errorf("asset not found"))
+		return
+	}
+
+	writeData(w, map[string]interface{}{"asset": asset})
+}
+
+func (fn *FakeNode) handleStatus(w http.ResponseWriter, r *http.Request) {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	writeData(w, map[string]interface{}{"metrics": map[string]interface{}{"klv_nonce": fn.blockNum}})
+}
+
+func (fn *FakeNode) handleSend(w http.ResponseWriter, r *http.Request) {
+	request := &models.SendTXRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err)
+		return
+	}
+
+	// after the JSON round trip every contract is a map, keep the request type
+	// for contracts not built by MultiSend
+	for _, c := range request.Contracts {
+		if m, ok := c.(map[string]interface{}); ok {
+			if _, exists := m["contractType"]; !exists {
+				m["contractType"] = float64(request.Type)
+			}
+		}
+	}
+
+	tx, err := fn.builder.BuildTransaction(request, &models.OfflineTXOptions{
+		ChainID:      fn.ChainID,
+		Version:      1,
+		KAppFee:      fn.KAppFee,
+		BandwidthFee: fn.BandwidthFee,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err)
+		return
+	}
+
+	writeData(w, map[string]interface{}{"result": tx})
+}
+
+func (fn *FakeNode) handleDecode(w http.ResponseWriter, r *http.Request) {
+	tx := &proto.Transaction{}
+	if err := json.NewDecoder(r.Body).Decode(tx); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err)
+		return
+	}
+
+	decoded, err := decodeTransaction(tx)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err)
+		return
+	}
+
+	writeData(w, map[string]interface{}{"tx": decoded})
+}
+
+func (fn *FakeNode) handleBroadcast(w http.ResponseWriter, r *http.Request) {
+	request := struct {
+		TX  *proto.Transaction   `json:"tx"`
+		TXs []*proto.Transaction `json:"txs"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err)
+		return
+	}
+
+	if request.TX != nil {
+		hash, err := fn.apply(request.TX)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", err)
+			return
+		}
+
+		writeData(w, map[string]interface{}{"txCount": 1, "txHash": hash})
+		return
+	}
+
+	hashes := make([]string, 0, len(request.TXs))
+	for _, tx := range request.TXs {
+		hash, err := fn.apply(tx)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", err)
+			return
+		}
+		hashes = append(hashes, hash)
+	}
+
+	writeData(w, map[string]interface{}{"txsHashes": hashes})
+}
+
+func (fn *FakeNode) handleTransaction(w http.ResponseWriter, r *http.Request) {
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	tx, exists := fn.transactions[r.PathValue("hash")]
+	if !exists {
+		writeError(w, http.StatusNotFound, "internal_issue", fmt.Errorf("transaction not found"))
+		return
+	}
+
+	writeData(w, map[string]interface{}{"transaction": tx})
+}
+
+func decodeTransaction(tx *proto.Transaction) (*models.TransactionAPI, error) {
+	raw := tx.GetRawData()
+	if raw == nil {
+		return nil, fmt.Errorf("transaction without raw data")
+	}
+
+	sender, err := address.NewAddressFromBytes(raw.GetSender())
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender: %w", err)
+	}
+
+	data := make([]string, 0, len(raw.GetData()))
+	for _, d := range raw.GetData() {
+		data = append(data, string(d))
+	}
+
+	signatures := make([]string, 0, len(tx.GetSignature()))
+	for _, s := range tx.GetSignature() {
+		signatures = append(signatures, hex.EncodeToString(s))
+	}
+
+	decoded := &models.TransactionAPI{
+		Hash:         hex.EncodeToString(tx.Hash),
+		Sender:       sender.Bech32(),
+		Nonce:        raw.GetNonce(),
+		PermissionID: raw.GetPermissionID(),
+		Data:         data,
+		KAppFee:      raw.GetKAppFee(),
+		BandwidthFee: raw.GetBandwidthFee(),
+		Version:      raw.GetVersion(),
+		ChainID:      string(raw.GetChainID()),
+		Signature:    signatures,
+	}
+
+	for _, c := range raw.GetContract() {
+		parameter := make(map[string]interface{})
+		if c.GetParameter().GetTypeUrl() == JSONContractTypeURL {
+			if err := json.Unmarshal(c.GetParameter().GetValue(), &parameter); err != nil {
+				return nil, fmt.Errorf("invalid %s parameter: %w", c.GetType(), err)
+			}
+		}
+
+		decoded.Contracts = append(decoded.Contracts, &models.TXContractAPI{
+			Type:       c.GetType(),
+			TypeString: c.GetType().String(),
+			Parameter:  parameter,
+		})
+	}
+
+	return decoded, nil
+}
+
+func (fn *FakeNode) apply(tx *proto.Transaction) (string, error) {
+	decoded, err := decodeTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
+	if len(tx.Hash) == 0 {
+		return "", fmt.Errorf("transaction without hash")
+	}
+
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	if _, exists := fn.transactions[decoded.Hash]; exists {
+		return "", fmt.Errorf("transaction already exists")
+	}
+
+	sender := fn.account(decoded.Sender)
+	if decoded.Nonce < sender.Nonce {
+		return "", fmt.Errorf("lower nonce in transaction")
+	}
+	if decoded.Nonce > sender.Nonce {
+		return "", fmt.Errorf("higher nonce in transaction")
+	}
+
+	fees := decoded.KAppFee + decoded.BandwidthFee
+	if sender.Balance < fees {
+		return "", fmt.Errorf("insufficient funds for fee")
+	}
+
+	fn.blockNum++
+	decoded.BlockNum = fn.blockNum
+	decoded.Status = provider.TXStatusSuccess
+	decoded.ResultCode = proto.Transaction_Ok.String()
+
+	sender.Nonce++
+	sender.Balance -= fees
+
+	// contracts are applied on a copy, a failed contract reverts all of them
+	snapshot := fn.snapshot()
+	for _, c := range decoded.Contracts {
+		if code := fn.applyContract(sender.Address, c, decoded.Hash); code != proto.Transaction_Ok {
+			fn.restore(snapshot)
+			decoded.Status = provider.TXStatusFail
+			decoded.ResultCode = code.String()
+			break
+		}
+	}
+
+	fn.transactions[decoded.Hash] = decoded
+
+	return decoded.Hash, nil
+}
+
+type fakeNodeState struct {
+	accounts map[string]models.Account
+	kdas     map[string]map[string]models.AccountKDA
+	assets   map[string]*proto.KDAData
+}
+
+func (fn *FakeNode) snapshot() fakeNodeState {
+	state := fakeNodeState{
+		accounts: make(map[string]models.Account),
+		kdas:     make(map[string]map[string]models.AccountKDA),
+		assets:   make(map[string]*proto.KDAData),
+	}
+
+	for addr, acc := range fn.accounts {
+		info := *acc.AccountInfo
+		state.accounts[addr] = models.Account{AccountInfo: &info}
+		state.kdas[addr] = make(map[string]models.AccountKDA)
+		for kda, accKDA := range acc.Assets {
+			state.kdas[addr][kda] = *accKDA
+		}
+	}
+
+	for id, asset := range fn.assets {
+		state.assets[id] = asset
+	}
+
+	return state
+}
+
+func (fn *FakeNode) restore(state fakeNodeState) {
+	fn.accounts = make(map[string]*models.Account)
+	for addr, acc := range state.accounts {
+		restored := &models.Account{AccountInfo: acc.AccountInfo, Assets: make(map[string]*models.AccountKDA)}
+		for kda, accKDA := range state.kdas[addr] {
+			accKDA := accKDA
+			restored.Assets[kda] = &accKDA
+		}
+		fn.accounts[addr] = restored
+	}
+
+	fn.assets = state.assets
+}
+
+func (fn *FakeNode) applyContract(sender string, c *models.TXContractAPI, hash string) proto.Transaction_TXResultCode {
+	parameter, _ := c.Parameter.(map[string]interface{})
+	data, err := json.Marshal(parameter)
+	if err != nil {
+		return proto.Transaction_ContractInvalid
+	}
+
+	switch c.Type {
+	case proto.TXContract_TransferContractType:
+		transfer := models.TransferTXRequest{}
+		if err := json.Unmarshal(data, &transfer); err != nil || transfer.Amount <= 0 {
+			return proto.Transaction_AmountInvalid
+		}
+		if _, err := address.NewAddress(transfer.Receiver); err != nil {
+			return proto.Transaction_AccountError
+		}
+
+		if code := fn.debit(sender, transfer.KDA, transfer.Amount); code != proto.Transaction_Ok {
+			return code
+		}
+		fn.credit(transfer.Receiver, transfer.KDA, transfer.Amount)
+
+	case proto.TXContract_FreezeContractType:
+		freeze := models.FreezeTXRequest{}
+		if err := json.Unmarshal(data, &freeze); err != nil || freeze.Amount <= 0 {
+			return proto.Transaction_AmountInvalid
+		}
+
+		if code := fn.debit(sender, freeze.KDA, freeze.Amount); code != proto.Transaction_Ok {
+			return code
+		}
+
+		acc := fn.account(sender)
+		if freeze.KDA == core.KLV || len(freeze.KDA) == 0 {
+			acc.FrozenBalance += freeze.Amount
+		} else {
+			fn.accountKDA(acc, freeze.KDA).FrozenBalance += freeze.Amount
+		}
+
+	case proto.TXContract_CreateAssetContractType:
+		create := models.CreateAssetTXRequest{}
+		if err := json.Unmarshal(data, &create); err != nil || len(create.Ticker) == 0 {
+			return proto.Transaction_ParameterInvalid
+		}
+
+		owner := create.OwnerAddress
+		if len(owner) == 0 {
+			owner = sender
+		}
+
+		assetID := fmt.Sprintf("%s-%s", strings.ToUpper(create.Ticker), strings.ToUpper(hash[:4]))
+		if _, exists := fn.assets[assetID]; exists {
+			return proto.Transaction_AlreadyExists
+		}
+
+		fn.assets[assetID] = &proto.KDAData{
+			AssetType:         proto.KDAData_EnumAssetType(create.Type),
+			ID:                []byte(assetID),
+			Name:              []byte(create.Name),
+			Ticker:            []byte(create.Ticker),
+			OwnerAddress:      []byte(owner),
+			Logo:              create.Logo,
+			URIs:              create.URIs,
+			Precision:         create.Precision,
+			InitialSupply:     create.InitialSupply,
+			CirculatingSupply: create.InitialSupply,
+			MaxSupply:         create.MaxSupply,
+		}
+
+		if create.InitialSupply > 0 {
+			fn.credit(owner, assetID, create.InitialSupply)
+		}
+	}
+
+	return proto.Transaction_Ok
+}
+
+func (fn *FakeNode) debit(addr string, kda string, amount int64) proto.Transaction_TXResultCode {
+	acc := fn.account(addr)
+	if kda == core.KLV || len(kda) == 0 {
+		if acc.Balance < amount {
+			return proto.Transaction_OutOfFunds
+		}
+		acc.Balance -= amount
+		return proto.Transaction_Ok
+	}
+
+	if _, exists := fn.assets[kda]; !exists && !strings.Contains(kda, "/") {
+		return proto.Transaction_AssetIDInvalid
+	}
+
+	accKDA := fn.accountKDA(acc, kda)
+	if accKDA.Balance < amount {
+		return proto.Transaction_BalanceError
+	}
+	accKDA.Balance -= amount
+
+	return proto.Transaction_Ok
+}
+
+func (fn *FakeNode) credit(addr string, kda string, amount int64) {
+	acc := fn.account(addr)
+	if kda == core.KLV || len(kda) == 0 {
+		acc.Balance += amount
+		return
+	}
+
+	fn.accountKDA(acc, kda).Balance += amount
+}
@@ -0,0 +1,130 @@
+package testutil_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klever-go-sdk/core"
+	"github.com/klever-io/klever-go-sdk/models"
+	"github.com/klever-io/klever-go-sdk/models/proto"
+	"github.com/klever-io/klever-go-sdk/provider"
+	"github.com/klever-io/klever-go-sdk/provider/utils"
+	"github.com/klever-io/klever-go-sdk/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alice = "klv1usdnywjhrlv4tcyu6stxpl6yvhplg35nepljlt4y5r7yppe8er4qujlazy"
+	bob   = "klv1velayazgrn6mqaqckt7utk9656h8zu3ex4ln8rx7n8p0vy4fd20qmwh4p5"
+)
+
+func newFakeNode(t *testing.T) (*testutil.FakeNode, provider.KleverChain) {
+	fn, err := testutil.NewFakeNode()
+	require.Nil(t, err)
+	t.Cleanup(fn.Close)
+
+	kc, err := provider.NewKleverChain(fn.NetworkConfig(), utils.NewHttpClient(time.Second))
+	require.Nil(t, err)
+
+	return fn, kc
+}
+
+func sendAndWait(t *testing.T, kc provider.KleverChain, tx *proto.Transaction) (*models.TransactionAPI, error) {
+	hash, err := kc.BroadcastTransaction(tx)
+	require.Nil(t, err)
+
+	return kc.WaitForTransaction(context.Background(), hash, &models.WaitOptions{PollInterval: time.Millisecond})
+}
+
+func TestFakeNode_Transfer(t *testing.T) {
+	fn, kc := newFakeNode(t)
+	fn.KAppFee = 1000
+	fn.SetBalance(alice, core.KLV, 10_000000)
+
+	acc, err := kc.GetAccount(alice)
+	require.Nil(t, err)
+	assert.Equal(t, int64(10_000000), acc.Balance)
+
+	tx, err := kc.Send(&models.BaseTX{FromAddress: alice, Nonce: acc.Nonce}, bob, 1.5, core.KLV)
+	require.Nil(t, err)
+
+	decoded, err := kc.Decode(tx)
+	require.Nil(t, err)
+	assert.Equal(t, alice, decoded.Sender)
+	require.Len(t, decoded.Contracts, 1)
+	assert.Equal(t, proto.TXContract_TransferContractType, decoded.Contracts[0].Type)
+
+	result, err := sendAndWait(t, kc, tx)
+	require.Nil(t, err)
+	assert.Equal(t, provider.TXStatusSuccess, result.Status)
+
+	assert.Equal(t, int64(8_499000), fn.Balance(alice, core.KLV))
+	assert.Equal(t, int64(1_500000), fn.Balance(bob, core.KLV))
+	assert.Equal(t, uint64(1), fn.Nonce(alice))
+
+	// same nonce again
+	_, err = kc.BroadcastTransaction(tx)
+	assert.NotNil(t, err)
+
+	tx, err = kc.Send(&models.BaseTX{FromAddress: alice, Nonce: 0}, bob, 1, core.KLV)
+	require.Nil(t, err)
+	_, err = kc.BroadcastTransaction(tx)
+	assert.True(t, errors.Is(err, utils.ErrNonceTooLow))
+}
+
+func TestFakeNode_FailedContractReverts(t *testing.T) {
+	fn, kc := newFakeNode(t)
+	fn.SetBalance(alice, core.KLV, 1_000000)
+
+	tx, err := kc.MultiTransfer(&models.BaseTX{FromAddress: alice}, []models.ToAmount{
+		{ToAddress: bob, Amount: 0.5, KDA: core.KLV},
+		{ToAddress: bob, Amount: 0.6, KDA: core.KLV},
+	})
+	require.Nil(t, err)
+
+	result, err := sendAndWait(t, kc, tx)
+	assert.True(t, errors.Is(err, provider.ErrTXOutOfFunds))
+	assert.Equal(t, provider.TXStatusFail, result.Status)
+	assert.Equal(t, int64(1_000000), fn.Balance(alice, core.KLV))
+	assert.Equal(t, int64(0), fn.Balance(bob, core.KLV))
+	assert.Equal(t, uint64(1), fn.Nonce(alice))
+}
+
+func TestFakeNode_FreezeAndCreateAsset(t *testing.T) {
+	fn, kc := newFakeNode(t)
+	fn.SetBalance(alice, core.KLV, 5_000000)
+
+	tx, err := kc.Freeze(&models.BaseTX{FromAddress: alice}, 2, core.KLV)
+	require.Nil(t, err)
+	_, err = sendAndWait(t, kc, tx)
+	require.Nil(t, err)
+	assert.Equal(t, int64(3_000000), fn.Balance(alice, core.KLV))
+	assert.Equal(t, int64(2_000000), fn.FrozenBalance(alice, core.KLV))
+
+	tx, err = kc.CreateKDA(&models.BaseTX{FromAddress: alice, Nonce: 1}, proto.KDAData_Fungible, &models.KDAOptions{
+		Name:          "Test",
+		Ticker:        "TST",
+		Precision:     2,
+		InitialSupply: 100,
+		MaxSupply:     1000,
+	})
+	require.Nil(t, err)
+	result, err := sendAndWait(t, kc, tx)
+	require.Nil(t, err)
+
+	assetID := "TST-" + strings.ToUpper(result.Hash[:4])
+	asset, err := kc.GetAsset(assetID)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), asset.Precision)
+	assert.Equal(t, int64(100_00), fn.Balance(alice, assetID))
+
+	tx, err = kc.Send(&models.BaseTX{FromAddress: alice, Nonce: 2}, bob, 10.25, assetID)
+	require.Nil(t, err)
+	_, err = sendAndWait(t, kc, tx)
+	require.Nil(t, err)
+	assert.Equal(t, int64(10_25), fn.Balance(bob, assetID))
+}